@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Comparer can be implemented by a Pointer to be compared with another one, outside SQL.
+// All the built-in pointers implement it.
+type Comparer interface {
+	// Compare returns -1 if the pointer is less than other, 0 if they are equal, or +1 if it is greater.
+	// It panics if other does not have the same type.
+	Compare(other Pointer) int
+}
+
+// Compare compares two pointers, to be used as comparison function, such as with Slice.
+func Compare[T interface {
+	Pointer
+	Comparer
+}](a, b T) int {
+	return a.Compare(b)
+}
+
+// Collation defines how strings are compared.
+type Collation interface {
+	// Compare returns -1 if a is less than b, 0 if they are equal, or +1 if a is greater.
+	Compare(a, b string) int
+}
+
+// Binary compares strings byte by byte, as a binary collation, such as utf8mb4_bin.
+type Binary struct{}
+
+// Compare implements the Collation interface.
+func (Binary) Compare(a, b string) int {
+	return strings.Compare(a, b)
+}
+
+// CaseInsensitive compares strings without case sensitivity, as an accent-sensitive and case-insensitive collation,
+// such as utf8mb4_0900_as_ci.
+type CaseInsensitive struct{}
+
+// Compare implements the Collation interface.
+func (CaseInsensitive) Compare(a, b string) int {
+	if strings.EqualFold(a, b) {
+		return 0
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// CollatedString is a String compared with the collation C, to match how the database orders the column.
+type CollatedString[C Collation] string
+
+// Args implements the Pointer interface.
+func (s CollatedString[C]) Args() []any {
+	return String(s).Args()
+}
+
+// value implements the valuer interface.
+func (s CollatedString[C]) value() any {
+	return String(s)
+}
+
+// Compare implements the Comparer interface.
+func (s CollatedString[C]) Compare(other Pointer) int {
+	var c C
+	return c.Compare(string(s), string(mustBe[CollatedString[C]](s, other)))
+}
+
+// IsZero implements the Pointer interface.
+func (s CollatedString[C]) IsZero() bool {
+	return s == ""
+}
+
+// comparePointers compares two pointers implementing the Comparer interface.
+func comparePointers(a, b Pointer) int {
+	c, ok := a.(Comparer)
+	if !ok {
+		panic(fmt.Sprintf("cursor: %T does not implement Comparer", a))
+	}
+	return c.Compare(b)
+}
+
+// compareValues compares two values of the same type: pointers, times or ordered values.
+func compareValues(a, b any) int {
+	if p, ok := a.(Pointer); ok {
+		return comparePointers(p, mustBe[Pointer](a, b))
+	}
+	if t, ok := a.(time.Time); ok {
+		return t.Compare(mustBe[time.Time](a, b))
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Type() != vb.Type() {
+		panic(fmt.Sprintf("cursor: cannot compare %T with %T", a, b))
+	}
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(va.Int(), vb.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(va.Uint(), vb.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(va.Float(), vb.Float())
+	case reflect.String:
+		return strings.Compare(va.String(), vb.String())
+	case reflect.Bool:
+		return cmp.Compare(boolInt(va.Bool()), boolInt(vb.Bool()))
+	default:
+		panic(fmt.Sprintf("cursor: cannot compare %T values", a))
+	}
+}
+
+// compareLists compares the pointers one by one, a shorter list being less.
+func compareLists(a, b []Pointer) int {
+	for k := range min(len(a), len(b)) {
+		if c := comparePointers(a[k], b[k]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// mustBe returns other as T, or panics if it has not this type.
+func mustBe[T any](p, other any) T {
+	v, ok := other.(T)
+	if !ok {
+		panic(fmt.Sprintf("cursor: cannot compare %T with %T", p, other))
+	}
+	return v
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
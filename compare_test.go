@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rvflash/cursor"
+)
+
+func TestComparer_Compare(t *testing.T) {
+	t.Parallel()
+
+	var (
+		t1 = cursor.NewTime(instant, cursor.Millisecond)
+		t2 = cursor.NewTime(instant.Add(time.Millisecond), cursor.Millisecond)
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		a, b cursor.Pointer
+		// outputs
+		out int
+	}{
+		"Int64":            {a: cursor.Int64(prev), b: cursor.Int64(next), out: -1},
+		"Uint64":           {a: cursor.Uint64(next), b: cursor.Uint64(prev), out: 1},
+		"Uint64String":     {a: cursor.Uint64String(next), b: cursor.Uint64String(next), out: 0},
+		"Float64":          {a: cursor.Float64(1.5), b: cursor.Float64(-2), out: 1},
+		"Decimal":          {a: cursor.Decimal("9.75"), b: cursor.Decimal("10.5"), out: -1},
+		"Decimal scale":    {a: cursor.Decimal("10.50"), b: cursor.Decimal("10.5"), out: 0},
+		"Empty decimal":    {a: cursor.Decimal(""), b: cursor.Decimal("-1"), out: -1},
+		"String":           {a: cursor.String("a"), b: cursor.String("B"), out: 1},
+		"Binary":           {a: cursor.CollatedString[cursor.Binary]("a"), b: cursor.CollatedString[cursor.Binary]("B"), out: 1},
+		"Case insensitive": {a: cursor.CollatedString[cursor.CaseInsensitive]("a"), b: cursor.CollatedString[cursor.CaseInsensitive]("B"), out: -1},
+		"Case folding":     {a: cursor.CollatedString[cursor.CaseInsensitive]("Go"), b: cursor.CollatedString[cursor.CaseInsensitive]("gO"), out: 0},
+		"Time":             {a: t2, b: t1, out: 1},
+		"UUID":             {a: v7, b: v4, out: -1},
+		"BinaryUUID":       {a: cursor.BinaryUUID(v4), b: cursor.BinaryUUID(v7), out: 1},
+		"List":             {a: cursor.List{cursor.Int64(prev), cursor.String("b")}, b: cursor.List{cursor.Int64(prev), cursor.String("a")}, out: 1},
+		"Shorter list":     {a: cursor.List{cursor.Int64(prev)}, b: cursor.List{cursor.Int64(prev), cursor.String("a")}, out: -1},
+		"Tuple":            {a: cursor.NewTuple2(t1, cursor.Int64(next)), b: cursor.NewTuple2(t2, cursor.Int64(prev)), out: -1},
+		"Null":             {a: cursor.Null[cursor.Int64](), b: cursor.NewNullable(cursor.Int64(-prev)), out: -1},
+		"Nulls":            {a: cursor.Null[cursor.Int64](), b: cursor.Null[cursor.Int64](), out: 0},
+		"Nullable":         {a: cursor.NewNullable(cursor.Int64(next)), b: cursor.NewNullable(cursor.Int64(prev)), out: 1},
+		"Descending key":   {a: cursor.KeyOf(alice), b: cursor.KeyOf(bob), out: 1},
+		"Ascending key": {
+			a:   cursor.KeyOf(user{CreatedAt: alice.CreatedAt, ID: prev}),
+			b:   cursor.KeyOf(user{CreatedAt: alice.CreatedAt, ID: next}),
+			out: -1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, ok := tc.a.(cursor.Comparer)
+			if !ok {
+				t.Fatalf("%T does not implement Comparer", tc.a)
+			}
+			if out := c.Compare(tc.b); out != tc.out {
+				t.Errorf("\ngot %d\nexp %d", out, tc.out)
+			}
+			if out := tc.b.(cursor.Comparer).Compare(tc.a); out != -tc.out {
+				t.Errorf("\ngot %d\nexp %d", out, -tc.out)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	if out := cursor.Compare(cursor.Int64(prev), cursor.Int64(next)); out != -1 {
+		t.Errorf("\ngot %d\nexp %d", out, -1)
+	}
+	for name, tc := range map[string]struct {
+		fn  func()
+		msg string
+	}{
+		"Mismatch": {
+			fn:  func() { cursor.Int64(prev).Compare(cursor.String("a")) },
+			msg: "cursor: cannot compare cursor.Int64 with cursor.String",
+		},
+		"List mismatch": {
+			fn:  func() { cursor.List{cursor.Int64(prev)}.Compare(cursor.List{cursor.String("a")}) },
+			msg: "cursor: cannot compare cursor.Int64 with cursor.String",
+		},
+		"Not a comparer": {
+			fn:  func() { cursor.List{country("FR")}.Compare(cursor.List{country("US")}) },
+			msg: "cursor: cursor_test.country does not implement Comparer",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if r := recover(); r != tc.msg {
+					t.Errorf("\ngot %v\nexp %s", r, tc.msg)
+				}
+			}()
+			tc.fn()
+		})
+	}
+}
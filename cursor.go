@@ -9,6 +9,7 @@ package cursor
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"net/url"
@@ -22,6 +23,8 @@ var (
 	now = time.Now
 	b64 = base64.RawURLEncoding
 	sep = []byte(".")
+
+	errNoItem = errors.New("item not found")
 )
 
 // First returns the cursor of the first page.
@@ -41,6 +44,22 @@ func First[T Pointer](c *Cursor[T]) *Cursor[T] {
 	}
 }
 
+// Item returns the cursor of the page starting with the item at this index in the order of the calls to Add,
+// or nil if the items are not tracked or the index is out of range.
+func Item[T Pointer](c *Cursor[T], i int) *Cursor[T] {
+	if c == nil || i < 0 || i >= len(c.items) {
+		return nil
+	}
+	d := c.items[i]
+	return &Cursor[T]{
+		Next:    &d,
+		Offset:  c.Offset + i,
+		Limit:   c.Limit,
+		Total:   c.Total,
+		Filters: c.Filters,
+	}
+}
+
 // Last returns the cursor of the last page.
 func Last[T Pointer](c *Cursor[T]) *Cursor[T] {
 	if c == nil || c.Next == nil {
@@ -56,7 +75,7 @@ func Last[T Pointer](c *Cursor[T]) *Cursor[T] {
 	if total > notFound {
 		offset = c.Limit * (total - 1)
 	}
-	if c.Offset == offset-c.Limit {
+	if c.Offset+c.size() == offset {
 		return Next(c)
 	}
 	return &Cursor[T]{
@@ -88,21 +107,81 @@ func Next[T Pointer](c *Cursor[T]) *Cursor[T] {
 	}
 	return &Cursor[T]{
 		Next:    c.Next,
-		Offset:  c.Offset + c.Limit,
+		Offset:  c.Offset + c.size(),
 		Limit:   c.Limit,
 		Total:   c.Total,
 		Filters: c.Filters,
 	}
 }
 
+// Page returns the cursor of the page number n, or nil if it does not exist.
+// Without pointer, the page is reached by offset. Otherwise, the rows are skipped from the nearest anchor:
+// the start of the list, the first item of the current page, or the one starting the next page.
+// Skipping backward, the Statement fetches one more row in first, see Statement.Limit and Statement.Offset:
+// this row starts the next page, it is not part of the page and must be used as its Next pointer.
+// Query, Slice and Paginator handle it, only the users of the Statement have to drop it.
+func Page[T Pointer](c *Cursor[T], n int) *Cursor[T] {
+	if c == nil || c.Limit == 0 || n < 1 {
+		return nil
+	}
+	last := c.TotalPages()
+	if last > notFound && n > max(last, 1) {
+		return nil
+	}
+	p := &Cursor[T]{
+		Offset:  (n - 1) * c.Limit,
+		Limit:   c.Limit,
+		Total:   c.Total,
+		Filters: c.Filters,
+	}
+	switch {
+	case n == 1:
+		p.Prev = new(T)
+		return p
+	case n == last:
+		p.Next = new(T)
+		return p
+	}
+	skip := p.Offset
+	if c.isEmpty() {
+		return p
+	}
+	anchor := func(prev, next *T, rows int) {
+		if rows < 0 || rows >= skip {
+			return
+		}
+		p.Prev, p.Next, p.Skip, skip = prev, next, rows, rows
+	}
+	if c.Prev != nil && !(*c.Prev).IsZero() {
+		// Forward from the first item of the current page, included, or backward before it.
+		anchor(nil, c.Prev, p.Offset-c.Offset)
+		anchor(c.Prev, nil, c.Offset-p.Offset-c.Limit)
+	}
+	if c.Next != nil && !(*c.Next).IsZero() {
+		anchor(nil, c.Next, p.Offset-c.Offset-c.size())
+	}
+	return p
+}
+
 // Prev returns the cursor of the previous page.
+// Before a page starting with an item, see Item, the previous page is shorter, to end at the boundary of a page.
 func Prev[T Pointer](c *Cursor[T]) *Cursor[T] {
-	if c == nil || c.Prev == nil || c.Offset == 0 {
+	if c == nil || c.Prev == nil || c.Offset == 0 || c.Limit == 0 {
 		return nil
 	}
 	if t := *c.Prev; t.IsZero() {
 		return nil
 	}
+	if n := c.Offset % c.Limit; n > 0 {
+		return &Cursor[T]{
+			Prev:    c.Prev,
+			Offset:  c.Offset - n,
+			Limit:   c.Limit,
+			Total:   c.Total,
+			Filters: c.Filters,
+			Size:    n,
+		}
+	}
 	if c.Offset == c.Limit {
 		return First(c)
 	}
@@ -115,6 +194,33 @@ func Prev[T Pointer](c *Cursor[T]) *Cursor[T] {
 	}
 }
 
+// Self returns the cursor to fetch again the current page, bounded by the first item notified by Add
+// and the one starting the next page, or the last item of the page without next page.
+func Self[T Pointer](c *Cursor[T]) *Cursor[T] {
+	if c == nil || c.Prev == nil {
+		return nil
+	}
+	if t := *c.Prev; t.IsZero() {
+		return nil
+	}
+	upper := c.Next
+	if upper == nil || (*upper).IsZero() {
+		upper = c.last
+	}
+	if upper == nil {
+		return nil
+	}
+	return &Cursor[T]{
+		Prev:    c.Prev,
+		Next:    upper,
+		Offset:  c.Offset,
+		Limit:   c.Limit,
+		Total:   c.Total,
+		Filters: c.Filters,
+		Window:  true,
+	}
+}
+
 // Cursor contains elements required to paginate based on a cursor, a data pointed the start of the data to list.
 type Cursor[T Pointer] struct {
 	Prev     *T    `json:"prev,omitempty"`
@@ -124,8 +230,17 @@ type Cursor[T Pointer] struct {
 	Limit    int        `json:"limit"`
 	Total    *int       `json:"total,omitempty"`
 	Filters  url.Values `json:"filters,omitempty"`
+	// Skip is the number of rows to skip from the pointer, see Page.
+	Skip int `json:"skip,omitempty"`
+	// Window defines a cursor selecting the rows from Prev to Next, both included, see Self.
+	Window bool `json:"window,omitempty"`
+	// Size is the number of rows of a page shorter than the limit, see Prev.
+	Size int `json:"size,omitempty"`
 
-	cnt int
+	cnt   int
+	last  *T
+	track bool
+	items []T
 }
 
 // Add notifies a new entry to the managed list of result.
@@ -139,15 +254,22 @@ func (c *Cursor[T]) Add(d T) {
 	case c.Limit:
 		c.Next = &d
 	}
+	if c.cnt < c.Limit {
+		c.last = &d
+		if c.track {
+			c.items = append(c.items, d)
+		}
+	}
 	c.cnt++
 }
 
 // CurrentPage returns the current page number.
+// A page starting with an item inside a page follows it, see Item.
 func (c *Cursor[T]) CurrentPage() int {
 	if c == nil || c.Offset == 0 || c.Limit == 0 {
 		return 1
 	}
-	return 1 + (c.Offset / c.Limit)
+	return 1 + (c.Offset+c.Limit-1)/c.Limit
 }
 
 // Decode decodes a plain cursor.
@@ -166,8 +288,9 @@ func (c *Cursor[T]) Decode(text []byte) error {
 }
 
 // Encode encodes the cursor as plain data.
+// A cursor without pointer is only encoded with an offset, to reach a page by its number.
 func (c *Cursor[T]) Encode() ([]byte, error) {
-	if c == nil || (c.Prev == nil && c.Next == nil) {
+	if c.isEmpty() && (c == nil || c.Offset == 0) {
 		return nil, nil
 	}
 	c.IssuedAt = now().Unix()
@@ -179,6 +302,19 @@ func (c *Cursor[T]) Encode() ([]byte, error) {
 	return b64Encode(src), nil
 }
 
+// ItemToken returns the signed cursor of the page starting with the item at this index, see Item.
+// Without secret, the cursor is only encoded.
+func (c *Cursor[T]) ItemToken(i int, secret []byte) (string, error) {
+	n := Item(c, i)
+	if n == nil {
+		return "", fmt.Errorf("item %d: %w", i, errNoItem)
+	}
+	if len(secret) == 0 {
+		return n.String(), nil
+	}
+	return encryptString(n, secret)
+}
+
 // IsExpired returns true if the issued timestamp exceeds the max age allowed.
 func (c *Cursor[T]) IsExpired(maxAge time.Duration) bool {
 	return c == nil || c.IssuedAt == 0 || time.Since(time.Unix(c.IssuedAt, 0)) > maxAge
@@ -191,7 +327,17 @@ func (c *Cursor[T]) Reset() {
 		Limit:   c.Limit,
 		Total:   c.Total,
 		Filters: c.Filters,
+		Size:    c.Size,
+		track:   c.track,
+	}
+}
+
+// size returns the number of rows of the page.
+func (c *Cursor[T]) size() int {
+	if c.Size > 0 && c.Size < c.Limit {
+		return c.Size
 	}
+	return c.Limit
 }
 
 // String implements the fmt.Stringer interface.
@@ -203,6 +349,11 @@ func (c *Cursor[T]) String() string {
 	return string(b)
 }
 
+// TrackItems enables the tracking of the items notified by Add, to get a cursor by item with Item or ItemToken.
+func (c *Cursor[T]) TrackItems() {
+	c.track = true
+}
+
 // TotalItems returns the total number of items, or -1 if unknown.
 func (c *Cursor[T]) TotalItems() int {
 	if c == nil || c.Total == nil {
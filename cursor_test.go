@@ -5,6 +5,7 @@
 package cursor_test
 
 import (
+	"cmp"
 	"net/url"
 	"reflect"
 	"strings"
@@ -86,6 +87,130 @@ func TestFirst(t *testing.T) {
 	}
 }
 
+func TestItem(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sum     = total
+		filters = url.Values{"new": []string{"true"}}
+		two     = cursor.Int64(2)
+		nxt     = cursor.Int64(next)
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		track bool
+		items []int64
+		i     int
+		// outputs
+		out *cursor.Cursor[cursor.Int64]
+	}{
+		"Default":      {i: 0},
+		"Not tracked":  {items: []int64{prev, 2, next}, i: 1},
+		"Out of range": {track: true, items: []int64{prev, 2, next}, i: limit},
+		"Negative":     {track: true, items: []int64{prev, 2, next}, i: -1},
+		"OK": {
+			track: true,
+			items: []int64{prev, 2, next},
+			i:     1,
+			out: &cursor.Cursor[cursor.Int64]{
+				Next:    &two,
+				Offset:  limit + 1,
+				Limit:   limit,
+				Total:   &sum,
+				Filters: filters,
+			},
+		},
+		"Short page": {
+			track: true,
+			items: []int64{next},
+			i:     0,
+			out: &cursor.Cursor[cursor.Int64]{
+				Next:    &nxt,
+				Offset:  limit,
+				Limit:   limit,
+				Total:   &sum,
+				Filters: filters,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &cursor.Cursor[cursor.Int64]{Offset: limit, Limit: limit, Total: &sum, Filters: filters}
+			if tc.track {
+				c.TrackItems()
+			}
+			for _, v := range tc.items {
+				c.Add(cursor.Int64(v))
+			}
+			out := cursor.Item(c, tc.i)
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestItem_Prev(t *testing.T) {
+	t.Parallel()
+
+	const size = 3
+	rows := make([]int64, 10)
+	for k := range rows {
+		rows[k] = int64(k + 1)
+	}
+	fetch := func(c *cursor.Cursor[cursor.Int64]) ([]int64, *cursor.Cursor[cursor.Int64]) {
+		out, c, err := cursor.Slice(cursor.Statement[cursor.Int64]{Cursor: c}, rows,
+			func(v int64) cursor.Int64 { return cursor.Int64(v) },
+			func(a, b cursor.Int64) int { return cmp.Compare(a, b) },
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out, c
+	}
+	_, c := fetch(cursor.New[cursor.Int64](size, len(rows)))
+	c = cursor.Next(c)
+	c.TrackItems()
+	_, c = fetch(c)
+	// The page starting with the third item of the second page, then backward and forward from it.
+	if c = cursor.Item(c, 2); c == nil {
+		t.Fatal("missing item")
+	}
+	for k, step := range []struct {
+		move func(*cursor.Cursor[cursor.Int64]) *cursor.Cursor[cursor.Int64]
+		rows []int64
+		page int
+	}{
+		{rows: []int64{6, 7, 8}, page: 3},
+		{move: cursor.Prev[cursor.Int64], rows: []int64{4, 5}, page: 2},
+		{move: cursor.Next[cursor.Int64], rows: []int64{6, 7, 8}, page: 3},
+		{move: cursor.Prev[cursor.Int64], rows: []int64{4, 5}, page: 2},
+		{move: cursor.Prev[cursor.Int64], rows: []int64{1, 2, 3}, page: 1},
+		{move: cursor.Prev[cursor.Int64], page: 1},
+		{move: cursor.Next[cursor.Int64], rows: []int64{4, 5, 6}, page: 2},
+	} {
+		if step.move != nil {
+			n := step.move(c)
+			if (n == nil) != (step.rows == nil) {
+				t.Fatalf("move %d: got %#v", k, n)
+			}
+			if n == nil {
+				continue
+			}
+			c = n
+		}
+		var out []int64
+		out, c = fetch(c)
+		if !reflect.DeepEqual(out, step.rows) {
+			t.Errorf("move %d:\ngot %#v\nexp %#v", k, out, step.rows)
+		}
+		if p := c.CurrentPage(); p != step.page {
+			t.Errorf("move %d: page\ngot %d\nexp %d", k, p, step.page)
+		}
+	}
+}
+
 func TestLast(t *testing.T) {
 	t.Parallel()
 
@@ -214,6 +339,65 @@ func TestNext(t *testing.T) {
 	}
 }
 
+func TestPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sum  = total * limit
+		prv  = cursor.Int64(9)
+		nxt  = cursor.Int64(11)
+		page = func(n, skip int, prev, next *cursor.Int64) *cursor.Cursor[cursor.Int64] {
+			return &cursor.Cursor[cursor.Int64]{
+				Prev: prev, Next: next, Offset: (n - 1) * limit, Skip: skip, Limit: limit, Total: &sum,
+			}
+		}
+		keyset = &cursor.Cursor[cursor.Int64]{Prev: &prv, Next: &nxt, Offset: limit * 4, Limit: limit, Total: &sum}
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		in *cursor.Cursor[cursor.Int64]
+		n  int
+		// outputs
+		out *cursor.Cursor[cursor.Int64]
+	}{
+		"Default":        {n: 1},
+		"Zero":           {in: keyset, n: 0},
+		"Out of range":   {in: keyset, n: total + 1},
+		"First page":     {in: keyset, n: 1, out: page(1, 0, new(cursor.Int64), nil)},
+		"Last page":      {in: keyset, n: total, out: page(total, 0, nil, new(cursor.Int64))},
+		"Current page":   {in: keyset, n: 5, out: page(5, 0, nil, &prv)},
+		"Next page":      {in: keyset, n: 6, out: page(6, 0, nil, &nxt)},
+		"After":          {in: keyset, n: 8, out: page(8, 4, nil, &nxt)},
+		"Prev page":      {in: keyset, n: 4, out: page(4, 0, &prv, nil)},
+		"Before":         {in: keyset, n: 3, out: page(3, 2, &prv, nil)},
+		"Near the start": {in: keyset, n: 2, out: page(2, 0, nil, nil)},
+		"Offset": {
+			in:  &cursor.Cursor[cursor.Int64]{Offset: limit * 4, Limit: limit, Total: &sum},
+			n:   8,
+			out: page(8, 0, nil, nil),
+		},
+		"Unknown total": {
+			in: &cursor.Cursor[cursor.Int64]{Prev: &prv, Next: &nxt, Offset: limit * 4, Limit: limit},
+			n:  total,
+			out: &cursor.Cursor[cursor.Int64]{
+				Next: &nxt, Offset: (total - 1) * limit, Skip: 8, Limit: limit,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := cursor.Page(tc.in, tc.n)
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+			if out != nil && out.CurrentPage() != tc.n {
+				t.Errorf("\ngot %d\nexp %d", out.CurrentPage(), tc.n)
+			}
+		})
+	}
+}
+
 func TestPrev(t *testing.T) {
 	t.Parallel()
 
@@ -269,6 +453,20 @@ func TestPrev(t *testing.T) {
 				Prev:  &one,
 			},
 		},
+		"Unaligned": {
+			in: &cursor.Cursor[cursor.Int64]{
+				Offset: limit + 1,
+				Limit:  limit,
+				Prev:   &nxt,
+				Next:   &four,
+			},
+			out: &cursor.Cursor[cursor.Int64]{
+				Offset: limit,
+				Limit:  limit,
+				Prev:   &nxt,
+				Size:   1,
+			},
+		},
 		"Page 3": {
 			in: &cursor.Cursor[cursor.Int64]{
 				Offset: limit * 2,
@@ -335,6 +533,56 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestSelf(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sum   = total
+		one   = cursor.Int64(prev)
+		two   = cursor.Int64(prev + 1)
+		three = cursor.Int64(next)
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		items []int64
+		// outputs
+		out *cursor.Cursor[cursor.Int64]
+	}{
+		"Default": {},
+		"One item": {
+			items: []int64{prev},
+			out: &cursor.Cursor[cursor.Int64]{
+				Prev: &one, Next: &one, Offset: limit, Limit: limit, Total: &sum, Window: true,
+			},
+		},
+		"Last page": {
+			items: []int64{prev, prev + 1},
+			out: &cursor.Cursor[cursor.Int64]{
+				Prev: &one, Next: &two, Offset: limit, Limit: limit, Total: &sum, Window: true,
+			},
+		},
+		"OK": {
+			items: []int64{prev, prev + 1, next},
+			out: &cursor.Cursor[cursor.Int64]{
+				Prev: &one, Next: &three, Offset: limit, Limit: limit, Total: &sum, Window: true,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &cursor.Cursor[cursor.Int64]{Offset: limit, Limit: limit, Total: &sum}
+			for _, v := range tc.items {
+				c.Add(cursor.Int64(v))
+			}
+			out := cursor.Self(c)
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
 func TestCursor_Add(t *testing.T) {
 	t.Parallel()
 
@@ -406,6 +654,7 @@ func TestCursor_CurrentPage(t *testing.T) {
 		"Blank":      {in: &cursor.Cursor[cursor.Int64]{}, out: 1},
 		"First page": {in: &cursor.Cursor[cursor.Int64]{Limit: limit}, out: 1},
 		"OK":         {in: &cursor.Cursor[cursor.Int64]{Limit: limit, Offset: limit * 3}, out: 4},
+		"Item":       {in: &cursor.Cursor[cursor.Int64]{Limit: limit, Offset: limit*3 + 1}, out: 5},
 	} {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
@@ -454,6 +703,54 @@ func TestCursor_Decode(t *testing.T) {
 	}
 }
 
+func TestCursor_ItemToken(t *testing.T) {
+	t.Parallel()
+
+	c := cursor.New[cursor.Int64](limit, total)
+	c.Filters.Set("new", "true")
+	c.TrackItems()
+	c.Reset()
+	for _, v := range []int64{prev, 2, next} {
+		c.Add(cursor.Int64(v))
+	}
+	_, err := c.ItemToken(limit, []byte(secret))
+	checkErr(t, err, "item 2: item not found")
+
+	for name, tc := range map[string]struct {
+		// inputs
+		secret []byte
+		// outputs
+		next cursor.Int64
+	}{
+		"Default": {next: 2},
+		"OK":      {secret: []byte(secret), next: 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := c.ItemToken(1, tc.secret)
+			if err != nil {
+				checkErr(t, err, "")
+				return
+			}
+			var out *cursor.Cursor[cursor.Int64]
+			if len(tc.secret) > 0 {
+				out, err = cursor.Decrypt[cursor.Int64]([]byte(s), tc.secret)
+			} else {
+				out = new(cursor.Cursor[cursor.Int64])
+				err = out.Decode([]byte(s))
+			}
+			if err != nil {
+				checkErr(t, err, "")
+				return
+			}
+			if out.Next == nil || *out.Next != tc.next || out.Offset != 1 || out.Filters.Get("new") != "true" {
+				t.Errorf("\ngot %#v\nexp next %d at offset 1", out, tc.next)
+			}
+		})
+	}
+}
+
 func TestCursor_IsExpired(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,293 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+// Package cursortest provides a conformance test kit for the paginated sources.
+// It walks through the pages of a reference dataset with randomized limits and moves: forward, backward,
+// to the last and to the first pages, and checks that each page contains the expected rows, in order.
+// On failure, it reports the minimal sequence of moves reproducing it.
+package cursortest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/rvflash/cursor"
+)
+
+// Default settings.
+const (
+	DefaultWalks = 100
+	DefaultSteps = 20
+)
+
+// Step is a move from a page to another one.
+type Step int
+
+// List of moves.
+const (
+	First Step = iota
+	Prev
+	Next
+	Last
+	_stepLen
+)
+
+// String implements the fmt.Stringer interface.
+func (s Step) String() string {
+	switch s {
+	case First:
+		return "First"
+	case Prev:
+		return "Prev"
+	case Next:
+		return "Next"
+	case Last:
+		return "Last"
+	default:
+		return fmt.Sprintf("Step(%d)", int(s))
+	}
+}
+
+// move returns the cursor of the page reached with this step.
+func move[T cursor.Pointer](s Step, c *cursor.Cursor[T]) *cursor.Cursor[T] {
+	switch s {
+	case First:
+		return cursor.First(c)
+	case Prev:
+		return cursor.Prev(c)
+	case Next:
+		return cursor.Next(c)
+	default:
+		return cursor.Last(c)
+	}
+}
+
+// Config configures the conformance test.
+type Config[T cursor.Pointer, R any] struct {
+	// Rows is the reference dataset, in display order.
+	Rows []R
+	// Fetch returns the rows of the page in display order, and updates the cursor with them.
+	// For example, by using cursor.Query or cursor.Slice.
+	Fetch cursor.Fetcher[T, R]
+	// DescendingOrder defines the result's order by default.
+	DescendingOrder bool
+	// MaxLimit is the max limit of the pages, the number of rows plus one by default.
+	MaxLimit int
+	// Walks is the number of walks through the pages, DefaultWalks by default.
+	Walks int
+	// Steps is the number of moves of each random walk, DefaultSteps by default.
+	Steps int
+	// Seed initializes the random walks. Zero uses a random seed, reported on failure.
+	Seed uint64
+	// Equal reports whether two rows are equal, reflect.DeepEqual by default.
+	Equal func(a, b R) bool
+}
+
+// Failure describes the minimal sequence of moves failing.
+type Failure struct {
+	// Seed is the seed of the random walks.
+	Seed uint64
+	// Limit is the limit of the pages.
+	Limit int
+	// Steps are the moves from the first page.
+	Steps []Step
+	// Err is the failure of the last move.
+	Err error
+}
+
+// Error implements the error interface.
+func (f *Failure) Error() string {
+	steps := make([]string, len(f.Steps))
+	for k, s := range f.Steps {
+		steps[k] = s.String()
+	}
+	return fmt.Sprintf("cursortest: seed %d, limit %d, steps [%s]: %s",
+		f.Seed, f.Limit, strings.Join(steps, " "), f.Err)
+}
+
+// Unwrap returns the underlying error.
+func (f *Failure) Unwrap() error {
+	return f.Err
+}
+
+// Run runs Check and reports its failure, if any.
+func Run[T cursor.Pointer, R any](t testing.TB, cfg Config[T, R]) {
+	t.Helper()
+	if err := Check(t.Context(), cfg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Check walks through the pages, forward from the first one, backward from the last one,
+// then randomly, and checks that each page contains the expected rows, in order.
+// Each cursor is encoded and decoded between two moves, as done with a token.
+// It returns a *Failure with the minimal sequence of moves reproducing the first failure found.
+func Check[T cursor.Pointer, R any](ctx context.Context, cfg Config[T, R]) error {
+	if cfg.Fetch == nil {
+		return errors.New("cursortest: missing fetch")
+	}
+	cfg = cfg.defaults()
+	var (
+		seed = cfg.Seed
+		n    = len(cfg.Rows)
+	)
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+	r := rand.New(rand.NewPCG(seed, seed))
+	for k := range cfg.Walks {
+		var (
+			limit = 1 + r.IntN(cfg.MaxLimit)
+			pages = max(1, (n+limit-1)/limit)
+			steps []Step
+		)
+		switch k {
+		case 0:
+			// Forward, until the end.
+			steps = slices.Repeat([]Step{Next}, pages)
+		case 1:
+			// Backward from the last page, until the beginning.
+			steps = append([]Step{Last}, slices.Repeat([]Step{Prev}, pages)...)
+		default:
+			steps = make([]Step, cfg.Steps)
+			for i := range steps {
+				steps[i] = Step(r.IntN(int(_stepLen)))
+			}
+		}
+		if i, err := cfg.walk(ctx, limit, steps); err != nil {
+			f := cfg.shrink(ctx, limit, steps[:i], err)
+			f.Seed = seed
+			return f
+		}
+	}
+	return nil
+}
+
+func (cfg Config[T, R]) defaults() Config[T, R] {
+	if cfg.MaxLimit <= 0 {
+		cfg.MaxLimit = len(cfg.Rows) + 1
+	}
+	if cfg.Walks <= 0 {
+		cfg.Walks = DefaultWalks
+	}
+	if cfg.Steps <= 0 {
+		cfg.Steps = DefaultSteps
+	}
+	if cfg.Equal == nil {
+		cfg.Equal = func(a, b R) bool { return reflect.DeepEqual(a, b) }
+	}
+	return cfg
+}
+
+// walk fetches the first page with this limit, then moves with the steps.
+// On failure, it returns the number of steps done, with the error.
+func (cfg Config[T, R]) walk(ctx context.Context, limit int, steps []Step) (int, error) {
+	var (
+		n     = len(cfg.Rows)
+		pages = max(1, (n+limit-1)/limit)
+		page  = 0
+		c     = cursor.New[T](limit, n)
+	)
+	err := cfg.check(ctx, c, page, limit)
+	if err != nil {
+		return 0, fmt.Errorf("first page: %w", err)
+	}
+	for k, s := range steps {
+		exp := expected(s, page, pages)
+		next := move(s, c)
+		switch {
+		case next == nil && exp == page:
+			continue
+		case next == nil:
+			return k + 1, fmt.Errorf("%s: missing cursor to page %d", s, exp+1)
+		case exp == page:
+			return k + 1, fmt.Errorf("%s: unexpected cursor on page %d", s, page+1)
+		}
+		c, err = roundTrip(next, limit, n)
+		if err != nil {
+			return k + 1, fmt.Errorf("%s: %w", s, err)
+		}
+		page = exp
+		err = cfg.check(ctx, c, page, limit)
+		if err != nil {
+			return k + 1, fmt.Errorf("%s: page %d: %w", s, page+1, err)
+		}
+	}
+	return 0, nil
+}
+
+// check fetches the page with the cursor and compares its rows with the expected ones.
+func (cfg Config[T, R]) check(ctx context.Context, c *cursor.Cursor[T], page, limit int) error {
+	rows, err := cfg.Fetch(ctx, cursor.Statement[T]{Cursor: c, DescendingOrder: cfg.DescendingOrder})
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+	exp := cfg.Rows[min(len(cfg.Rows), page*limit):min(len(cfg.Rows), (page+1)*limit)]
+	if !slices.EqualFunc(rows, exp, cfg.Equal) {
+		return fmt.Errorf("\ngot %v\nexp %v", rows, exp)
+	}
+	if p := c.CurrentPage(); p != page+1 {
+		return fmt.Errorf("current page: got %d, exp %d", p, page+1)
+	}
+	return nil
+}
+
+// shrink returns the minimal failure, by removing the steps and lowering the limit while it still fails.
+func (cfg Config[T, R]) shrink(ctx context.Context, limit int, steps []Step, err error) *Failure {
+	f := Failure{Limit: limit, Steps: steps, Err: err}
+	for shrunk := true; shrunk; {
+		shrunk = false
+		for k := 0; k < len(f.Steps); k++ {
+			try := slices.Delete(slices.Clone(f.Steps), k, k+1)
+			if i, err := cfg.walk(ctx, f.Limit, try); err != nil {
+				f.Steps, f.Err, shrunk = try[:i], err, true
+				k--
+			}
+		}
+		for l := 1; l < f.Limit; l++ {
+			if i, err := cfg.walk(ctx, l, f.Steps); err != nil {
+				f.Limit, f.Steps, f.Err, shrunk = l, f.Steps[:i], err, true
+				break
+			}
+		}
+	}
+	return &f
+}
+
+// expected returns the page expected after this step, or the same page if the move is not possible.
+func expected(s Step, page, pages int) int {
+	switch s {
+	case First:
+		return 0
+	case Prev:
+		return max(0, page-1)
+	case Next:
+		return min(pages-1, page+1)
+	default:
+		return pages - 1
+	}
+}
+
+// roundTrip encodes and decodes the cursor, or returns a new cursor without token.
+func roundTrip[T cursor.Pointer](c *cursor.Cursor[T], limit, total int) (*cursor.Cursor[T], error) {
+	token, err := c.Encode()
+	if err != nil {
+		return nil, fmt.Errorf("encode: %w", err)
+	}
+	if len(token) == 0 {
+		return cursor.New[T](limit, total), nil
+	}
+	var d cursor.Cursor[T]
+	err = d.Decode(token)
+	if err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+	return &d, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursortest_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/rvflash/cursor"
+	"github.com/rvflash/cursor/cursortest"
+)
+
+const seed = 42
+
+var ids = []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}
+
+func fetchSlice(_ context.Context, st cursor.Statement[cursor.Int64]) ([]int64, error) {
+	rows, _, err := cursor.Slice(st, ids, func(v int64) cursor.Int64 { return cursor.Int64(v) }, cursor.Compare[cursor.Int64])
+	return rows, err
+}
+
+// fetchExclusive wrongly starts the next pages after their first row.
+func fetchExclusive(ctx context.Context, st cursor.Statement[cursor.Int64]) ([]int64, error) {
+	if c := st.Cursor; c.Next != nil && !(*c.Next).IsZero() && c.Prev == nil {
+		n := *c.Next + 1
+		c.Next = &n
+	}
+	return fetchSlice(ctx, st)
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	for name, desc := range map[string]bool{"Ascending": false, "Descending": true} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rows := slices.Clone(ids)
+			if desc {
+				slices.Reverse(rows)
+			}
+			cursortest.Run(t, cursortest.Config[cursor.Int64, int64]{
+				Rows:            rows,
+				Fetch:           fetchSlice,
+				DescendingOrder: desc,
+				Seed:            seed,
+			})
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	oops := errors.New("oops")
+	for name, tc := range map[string]struct {
+		// inputs
+		fetch cursor.Fetcher[cursor.Int64, int64]
+		// outputs
+		limit int
+		steps []cursortest.Step
+		err   error
+	}{
+		"OK":      {fetch: fetchSlice},
+		"Missing": {err: errors.New("cursortest: missing fetch")},
+		"Failure": {
+			fetch: func(context.Context, cursor.Statement[cursor.Int64]) ([]int64, error) { return nil, oops },
+			limit: 1,
+			steps: []cursortest.Step{},
+			err:   oops,
+		},
+		"Exclusive": {fetch: fetchExclusive, limit: 1, steps: []cursortest.Step{cursortest.Next}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := cursortest.Check(context.Background(), cursortest.Config[cursor.Int64, int64]{
+				Rows:  ids,
+				Fetch: tc.fetch,
+				Seed:  seed,
+			})
+			var f *cursortest.Failure
+			switch {
+			case tc.limit == 0 && tc.err == nil:
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			case tc.limit == 0:
+				if err == nil || err.Error() != tc.err.Error() {
+					t.Fatalf("\ngot %v\nexp %v", err, tc.err)
+				}
+			case !errors.As(err, &f):
+				t.Fatalf("\ngot %v\nexp failure", err)
+			default:
+				if f.Seed != seed || f.Limit != tc.limit || !reflect.DeepEqual(f.Steps, tc.steps) {
+					t.Errorf("\ngot %#v\nexp limit %d, steps %v", f, tc.limit, tc.steps)
+				}
+				if tc.err != nil && !errors.Is(err, tc.err) {
+					t.Errorf("\ngot %v\nexp %v", err, tc.err)
+				}
+			}
+		})
+	}
+}
+
+func TestStep_String(t *testing.T) {
+	t.Parallel()
+
+	for s, exp := range map[cursortest.Step]string{
+		cursortest.First:   "First",
+		cursortest.Prev:    "Prev",
+		cursortest.Next:    "Next",
+		cursortest.Last:    "Last",
+		cursortest.Step(9): "Step(9)",
+	} {
+		if out := s.String(); out != exp {
+			t.Errorf("\ngot %q\nexp %q", out, exp)
+		}
+	}
+}
@@ -18,27 +18,37 @@ const (
 	_cursorLen
 )
 
+var (
+	// ErrMalformed is returned when the cursor can not be parsed or decoded.
+	ErrMalformed = errors.New("invalid cursor format")
+	// ErrSignatureMismatch is returned when the signature of the cursor does not match its content,
+	// the cursor has been tampered with or signed with another secret.
+	ErrSignatureMismatch = errors.New("signature mismatch")
+	// ErrExpired is returned when the cursor exceeds the max age allowed.
+	ErrExpired = errors.New("expired cursor")
+)
+
 // Decrypt decrypts the cursor, ensures its integrity by verifying its HMAC signature.
 func Decrypt[T Pointer](content, secret []byte) (*Cursor[T], error) {
 	raw := bytes.Split(content, sep)
 	if len(raw) != _cursorLen {
-		return nil, fmt.Errorf("parsing: invalid cursor format")
+		return nil, fmt.Errorf("parsing: %w", ErrMalformed)
 	}
 	src, err := b64Decode(raw[cursorSignature])
 	if err != nil {
-		return nil, fmt.Errorf("hash decoding: %w", err)
+		return nil, fmt.Errorf("hash decoding: %w: %w", ErrMalformed, err)
 	}
 	sig, err := sign(raw[cursorContent], secret)
 	if err != nil {
 		return nil, fmt.Errorf("signature checking: %w", err)
 	}
 	if !hmac.Equal(src, sig) {
-		return nil, errors.New("signature mismatch")
+		return nil, ErrSignatureMismatch
 	}
 	var c Cursor[T]
 	err = c.Decode(raw[cursorContent])
 	if err != nil {
-		return nil, fmt.Errorf("unmarshaling: %w", err)
+		return nil, fmt.Errorf("unmarshaling: %w: %w", ErrMalformed, err)
 	}
 	return &c, nil
 }
@@ -60,6 +70,19 @@ func Encrypt[T Pointer](c *Cursor[T], secret []byte) ([]byte, error) {
 	return bytes.Join([][]byte{src, b64Encode(sig)}, sep), nil
 }
 
+// decryptOrDecode decrypts the cursor with the secret, or only decodes it without secret.
+func decryptOrDecode[T Pointer](content, secret []byte) (*Cursor[T], error) {
+	if len(secret) > 0 {
+		return Decrypt[T](content, secret)
+	}
+	var c Cursor[T]
+	err := c.Decode(content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformed, err)
+	}
+	return &c, nil
+}
+
 func sign(content, secret []byte) ([]byte, error) {
 	mac := hmac.New(sha256.New, secret)
 	_, err := mac.Write(content)
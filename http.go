@@ -0,0 +1,158 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultParam is the default name of the query parameter containing the cursor.
+	DefaultParam = "cursor"
+
+	problemContentType = "application/problem+json"
+	problemTypeURL     = "https://pkg.go.dev/github.com/rvflash/cursor#"
+)
+
+// Config configures the Middleware.
+type Config struct {
+	// Param is the name of the query parameter containing the cursor, DefaultParam by default.
+	Param string
+	// Secret is used to verify the signature of the cursor. Without secret, the cursor is only decoded.
+	Secret []byte
+	// MaxAge is the max age allowed for a cursor. Zero disables the expiration check.
+	MaxAge time.Duration
+	// DefaultLimit is the limit of a new cursor, used without cursor in the request.
+	DefaultLimit int
+	// MaxLimit is the max limit allowed, zero means no limit.
+	MaxLimit int
+	// LimitParam is the name of the optional query parameter used to define the limit of a new cursor.
+	// Invalid values are ignored in favor of the DefaultLimit.
+	LimitParam string
+	// ErrorHandler handles the cursor failures. By default, a RFC 9457 problem is sent, see WriteProblem.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// Middleware returns a net/http middleware that decodes the cursor of the request, or creates a new one,
+// and stores it in the request's context, to be retrieved with FromContext.
+// Malformed, tampered or expired cursors are answered by the error handler.
+func Middleware[T Pointer](cfg Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c, err := requestCursor[T](cfg, r)
+			if err != nil {
+				if cfg.ErrorHandler != nil {
+					cfg.ErrorHandler(w, r, err)
+				} else {
+					WriteProblem(w, err)
+				}
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), c)))
+		})
+	}
+}
+
+// FromContext returns the cursor stored in the context, if any.
+func FromContext[T Pointer](ctx context.Context) (*Cursor[T], bool) {
+	c, ok := ctx.Value(contextKey[T]{}).(*Cursor[T])
+	return c, ok
+}
+
+// NewContext returns a copy of the context with the cursor.
+func NewContext[T Pointer](ctx context.Context, c *Cursor[T]) context.Context {
+	return context.WithValue(ctx, contextKey[T]{}, c)
+}
+
+// Problem is a RFC 9457 problem details object, describing a cursor failure.
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// NewProblem returns the problem describing this error.
+// Its type is the URL of the documentation of ErrMalformed, ErrSignatureMismatch or ErrExpired,
+// or about:blank for the other errors.
+func NewProblem(err error) *Problem {
+	p := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusBadRequest),
+		Status: http.StatusBadRequest,
+	}
+	for _, e := range []struct {
+		err   error
+		name  string
+		title string
+	}{
+		{err: ErrMalformed, name: "ErrMalformed", title: "Malformed cursor"},
+		{err: ErrSignatureMismatch, name: "ErrSignatureMismatch", title: "Tampered cursor"},
+		{err: ErrExpired, name: "ErrExpired", title: "Expired cursor"},
+	} {
+		if errors.Is(err, e.err) {
+			p.Type = problemTypeURL + e.name
+			p.Title = e.title
+			break
+		}
+	}
+	if err != nil {
+		p.Detail = err.Error()
+	}
+	return &p
+}
+
+// WriteProblem writes the problem describing this error as application/problem+json response.
+func WriteProblem(w http.ResponseWriter, err error) {
+	p := NewProblem(err)
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+type contextKey[T Pointer] struct{}
+
+func requestCursor[T Pointer](cfg Config, r *http.Request) (*Cursor[T], error) {
+	param := cfg.Param
+	if param == "" {
+		param = DefaultParam
+	}
+	q := r.URL.Query()
+	return parseCursor[T](cfg, q.Get(param), cfg.limit(q.Get(cfg.LimitParam)))
+}
+
+// parseCursor decodes and validates the token, or returns a new cursor with this limit without token.
+func parseCursor[T Pointer](cfg Config, token string, limit int) (*Cursor[T], error) {
+	if token == "" {
+		return New[T](limit, 0), nil
+	}
+	c, err := decryptOrDecode[T]([]byte(token), cfg.Secret)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxAge > 0 && c.IsExpired(cfg.MaxAge) {
+		return nil, ErrExpired
+	}
+	if cfg.MaxLimit > 0 && c.Limit > cfg.MaxLimit {
+		c.Limit = cfg.MaxLimit
+	}
+	return c, nil
+}
+
+func (cfg Config) limit(s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 || cfg.LimitParam == "" {
+		n = cfg.DefaultLimit
+	}
+	if cfg.MaxLimit > 0 {
+		return min(n, cfg.MaxLimit)
+	}
+	return n
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rvflash/cursor"
+)
+
+const oldToken = "eyJuZXh0IjowLCJpc3N1ZWRfYXQiOjE3NjIxMDEzMzYsImxpbWl0IjowfQ.icJNmFSIVfkw77vuW9fLZAr_L9j2e-s2HYI-SiflMRU"
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nxt = cursor.Int64(next)
+		cfg = cursor.Config{
+			Secret:       []byte(secret),
+			MaxAge:       time.Hour,
+			DefaultLimit: limit,
+			MaxLimit:     total,
+			LimitParam:   "limit",
+		}
+		token = mustEncrypt(t, &cursor.Cursor[cursor.Int64]{Next: &nxt, Offset: limit, Limit: total * 2})
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		cfg   cursor.Config
+		query url.Values
+		// outputs
+		status  int
+		limit   int
+		next    *cursor.Int64
+		problem string
+	}{
+		"Default":       {status: http.StatusOK, limit: 0},
+		"New":           {cfg: cfg, status: http.StatusOK, limit: limit},
+		"Limit":         {cfg: cfg, query: url.Values{"limit": {"5"}}, status: http.StatusOK, limit: 5},
+		"Max limit":     {cfg: cfg, query: url.Values{"limit": {"50"}}, status: http.StatusOK, limit: total},
+		"Invalid limit": {cfg: cfg, query: url.Values{"limit": {"-1"}}, status: http.StatusOK, limit: limit},
+		"Ignored limit": {cfg: cursor.Config{DefaultLimit: limit}, query: url.Values{"limit": {"5"}}, status: http.StatusOK, limit: limit},
+		"Malformed":     {cfg: cfg, query: url.Values{"cursor": {"abc"}}, status: http.StatusBadRequest, problem: "ErrMalformed"},
+		"Unsigned":      {query: url.Values{"cursor": {"!!"}}, status: http.StatusBadRequest, problem: "ErrMalformed"},
+		"Tampered":      {cfg: cfg, query: url.Values{"cursor": {oldToken[:len(oldToken)-1] + "A"}}, status: http.StatusBadRequest, problem: "ErrSignatureMismatch"},
+		"Expired":       {cfg: cfg, query: url.Values{"cursor": {oldToken}}, status: http.StatusBadRequest, problem: "ErrExpired"},
+		"Custom param":  {cfg: cursor.Config{Param: "c", Secret: []byte(secret)}, query: url.Values{"c": {token}}, status: http.StatusOK, limit: total * 2, next: &nxt},
+		"OK":            {cfg: cfg, query: url.Values{"cursor": {token}}, status: http.StatusOK, limit: total, next: &nxt},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *cursor.Cursor[cursor.Int64]
+			h := cursor.Middleware[cursor.Int64](tc.cfg)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got, _ = cursor.FromContext[cursor.Int64](r.Context())
+			}))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users?"+tc.query.Encode(), nil))
+
+			if w.Code != tc.status {
+				t.Fatalf("\ngot %d\nexp %d", w.Code, tc.status)
+			}
+			if tc.problem != "" {
+				checkProblem(t, w, tc.problem)
+				return
+			}
+			if got == nil {
+				t.Fatal("missing cursor in context")
+			}
+			if got.Limit != tc.limit || !reflect.DeepEqual(got.Next, tc.next) {
+				t.Errorf("\ngot %d %#v\nexp %d %#v", got.Limit, got.Next, tc.limit, tc.next)
+			}
+		})
+	}
+}
+
+func TestMiddleware_ErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	var got error
+	cfg := cursor.Config{
+		Secret: []byte(secret),
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+			got = err
+			w.WriteHeader(http.StatusTeapot)
+		},
+	}
+	h := cursor.Middleware[cursor.Int64](cfg)(http.NotFoundHandler())
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users?cursor=abc", nil))
+
+	if w.Code != http.StatusTeapot || !errors.Is(got, cursor.ErrMalformed) {
+		t.Errorf("\ngot %d %v\nexp %d %v", w.Code, got, http.StatusTeapot, cursor.ErrMalformed)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	c := cursor.New[cursor.Int64](limit, total)
+	if out, ok := cursor.FromContext[cursor.Int64](context.Background()); ok || out != nil {
+		t.Errorf("\ngot %#v\nexp nil", out)
+	}
+	ctx := cursor.NewContext(context.Background(), c)
+	if out, ok := cursor.FromContext[cursor.Int64](ctx); !ok || out != c {
+		t.Errorf("\ngot %#v\nexp %#v", out, c)
+	}
+	if out, ok := cursor.FromContext[cursor.String](ctx); ok || out != nil {
+		t.Errorf("\ngot %#v\nexp nil", out)
+	}
+}
+
+func TestNewProblem(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in error
+		// outputs
+		out *cursor.Problem
+	}{
+		"Default": {out: &cursor.Problem{Type: "about:blank", Title: "Bad Request", Status: http.StatusBadRequest}},
+		"Other": {
+			in:  errors.New("oops"),
+			out: &cursor.Problem{Type: "about:blank", Title: "Bad Request", Status: http.StatusBadRequest, Detail: "oops"},
+		},
+		"OK": {
+			in: cursor.ErrExpired,
+			out: &cursor.Problem{
+				Type:   "https://pkg.go.dev/github.com/rvflash/cursor#ErrExpired",
+				Title:  "Expired cursor",
+				Status: http.StatusBadRequest,
+				Detail: "expired cursor",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := cursor.NewProblem(tc.in)
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func checkProblem(t *testing.T, w *httptest.ResponseRecorder, name string) {
+	t.Helper()
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("\ngot %q\nexp %q", ct, "application/problem+json")
+	}
+	var p cursor.Problem
+	err := json.NewDecoder(w.Body).Decode(&p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "https://pkg.go.dev/github.com/rvflash/cursor#" + name; p.Type != exp || p.Status != w.Code {
+		t.Errorf("\ngot %q %d\nexp %q %d", p.Type, p.Status, exp, w.Code)
+	}
+}
@@ -0,0 +1,479 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package memsql
+
+import (
+	"bytes"
+	"cmp"
+	"database/sql/driver"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+type selectStmt struct {
+	with    *cte
+	columns []string
+	from    string
+	where   expr
+	orderBy []order
+	limit   operand
+	offset  operand
+	params  int
+}
+
+// cte is a common table expression, defined by the WITH clause.
+type cte struct {
+	name  string
+	query *selectStmt
+}
+
+type order struct {
+	column string
+	desc   bool
+}
+
+// row is a row of a table, used to evaluate the expressions.
+type row struct {
+	table  *table
+	values []driver.Value
+	args   []driver.Value
+}
+
+// run runs the statement and returns its resultset.
+func (s *selectStmt) run(tables map[string]*table, args []driver.Value) (*table, error) {
+	if s.with != nil {
+		t, err := s.with.query.run(tables, args)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", s.with.name, err)
+		}
+		tables = maps.Clone(tables)
+		tables[s.with.name] = t
+	}
+	src, ok := tables[s.from]
+	if !ok {
+		return nil, fmt.Errorf("unknown table %q", s.from)
+	}
+	var res [][]driver.Value
+	for _, values := range src.rows {
+		r := row{table: src, values: values, args: args}
+		if s.where != nil {
+			v, err := s.where.eval(r)
+			if err != nil {
+				return nil, err
+			}
+			if v != isTrue {
+				continue
+			}
+		}
+		res = append(res, values)
+	}
+	res, err := s.sort(src, res)
+	if err != nil {
+		return nil, err
+	}
+	res, err = s.slice(res, args)
+	if err != nil {
+		return nil, err
+	}
+	return s.project(src, res)
+}
+
+// sort sorts the rows with the ORDER BY clause.
+func (s *selectStmt) sort(src *table, rows [][]driver.Value) ([][]driver.Value, error) {
+	if len(s.orderBy) == 0 {
+		return rows, nil
+	}
+	idx := make([]int, len(s.orderBy))
+	for k, o := range s.orderBy {
+		i, err := src.column(o.column)
+		if err != nil {
+			return nil, err
+		}
+		idx[k] = i
+	}
+	var err error
+	slices.SortStableFunc(rows, func(a, b []driver.Value) int {
+		for k, o := range s.orderBy {
+			c, e := sortCompare(a[idx[k]], b[idx[k]])
+			if e != nil && err == nil {
+				err = e
+			}
+			if o.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+	return rows, err
+}
+
+// slice applies the LIMIT and OFFSET clauses.
+func (s *selectStmt) slice(rows [][]driver.Value, args []driver.Value) ([][]driver.Value, error) {
+	r := row{args: args}
+	if s.offset != nil {
+		n, err := count(s.offset, r)
+		if err != nil {
+			return nil, fmt.Errorf("offset: %w", err)
+		}
+		rows = rows[min(len(rows), n):]
+	}
+	if s.limit != nil {
+		n, err := count(s.limit, r)
+		if err != nil {
+			return nil, fmt.Errorf("limit: %w", err)
+		}
+		rows = rows[:min(len(rows), n)]
+	}
+	return rows, nil
+}
+
+// project returns the selected columns.
+func (s *selectStmt) project(src *table, rows [][]driver.Value) (*table, error) {
+	if len(s.columns) == 0 {
+		return &table{columns: src.columns, rows: rows}, nil
+	}
+	var (
+		res = table{columns: make([]string, len(s.columns)), rows: make([][]driver.Value, len(rows))}
+		idx = make([]int, len(s.columns))
+	)
+	for k, c := range s.columns {
+		i, err := src.column(c)
+		if err != nil {
+			return nil, err
+		}
+		idx[k], res.columns[k] = i, unqualified(c)
+	}
+	for k, r := range rows {
+		res.rows[k] = make([]driver.Value, len(idx))
+		for i, j := range idx {
+			res.rows[k][i] = r[j]
+		}
+	}
+	return &res, nil
+}
+
+type expr interface {
+	eval(r row) (truth, error)
+}
+
+// truth is the result of a condition, following the three-valued logic of SQL.
+// The values are ordered, so that AND returns the smallest operand and OR the greatest one.
+type truth int
+
+// List of truth values.
+const (
+	isFalse truth = iota
+	isUnknown
+	isTrue
+)
+
+func truthOf(b bool) truth {
+	if b {
+		return isTrue
+	}
+	return isFalse
+}
+
+type boolExpr bool
+
+func (e boolExpr) eval(row) (truth, error) {
+	return truthOf(bool(e)), nil
+}
+
+type andExpr struct {
+	left, right expr
+}
+
+func (e andExpr) eval(r row) (truth, error) {
+	a, err := e.left.eval(r)
+	if err != nil || a == isFalse {
+		return isFalse, err
+	}
+	b, err := e.right.eval(r)
+	if err != nil {
+		return isFalse, err
+	}
+	return min(a, b), nil
+}
+
+type orExpr struct {
+	left, right expr
+}
+
+func (e orExpr) eval(r row) (truth, error) {
+	a, err := e.left.eval(r)
+	if err != nil || a == isTrue {
+		return a, err
+	}
+	b, err := e.right.eval(r)
+	if err != nil {
+		return isFalse, err
+	}
+	return max(a, b), nil
+}
+
+type notExpr struct {
+	expr expr
+}
+
+// eval returns the negation of the expression, NOT of an unknown value being unknown.
+func (e notExpr) eval(r row) (truth, error) {
+	v, err := e.expr.eval(r)
+	if err != nil {
+		return isFalse, err
+	}
+	return isTrue - v, nil
+}
+
+type isNullExpr struct {
+	operand operand
+	not     bool
+}
+
+func (e isNullExpr) eval(r row) (truth, error) {
+	v, err := e.operand.value(r)
+	if err != nil {
+		return isFalse, err
+	}
+	return truthOf((v == nil) != e.not), nil
+}
+
+type betweenExpr struct {
+	operand, lower, upper operand
+}
+
+func (e betweenExpr) eval(r row) (truth, error) {
+	return andExpr{
+		left:  cmpExpr{op: ">=", left: e.operand, right: e.lower},
+		right: cmpExpr{op: "<=", left: e.operand, right: e.upper},
+	}.eval(r)
+}
+
+type cmpExpr struct {
+	op          string
+	left, right operand
+}
+
+// eval compares the operands, any comparison with NULL being unknown.
+func (e cmpExpr) eval(r row) (truth, error) {
+	a, err := e.left.value(r)
+	if err != nil {
+		return isFalse, err
+	}
+	b, err := e.right.value(r)
+	if err != nil {
+		return isFalse, err
+	}
+	if a == nil || b == nil {
+		return isUnknown, nil
+	}
+	c, err := compare(a, b)
+	if err != nil {
+		return isFalse, err
+	}
+	switch e.op {
+	case "=":
+		return truthOf(c == 0), nil
+	case "!=", "<>":
+		return truthOf(c != 0), nil
+	case "<":
+		return truthOf(c < 0), nil
+	case "<=":
+		return truthOf(c <= 0), nil
+	case ">":
+		return truthOf(c > 0), nil
+	default:
+		return truthOf(c >= 0), nil
+	}
+}
+
+type operand interface {
+	value(r row) (any, error)
+}
+
+type column string
+
+func (c column) value(r row) (any, error) {
+	if r.table == nil {
+		return nil, fmt.Errorf("unexpected column %q", string(c))
+	}
+	i, err := r.table.column(string(c))
+	if err != nil {
+		return nil, err
+	}
+	return r.values[i], nil
+}
+
+type param int
+
+func (p param) value(r row) (any, error) {
+	if int(p) >= len(r.args) {
+		return nil, fmt.Errorf("missing argument %d", int(p)+1)
+	}
+	return r.args[p], nil
+}
+
+type literal struct {
+	v any
+}
+
+func (l literal) value(row) (any, error) {
+	return l.v, nil
+}
+
+// decimal casts the operand as an exact number.
+type decimal struct {
+	operand operand
+}
+
+func (d decimal) value(r row) (any, error) {
+	v, err := d.operand.value(r)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return toRat(v)
+}
+
+// count returns the operand as a number of rows.
+func count(o operand, r row) (int, error) {
+	v, err := o.value(r)
+	if err != nil {
+		return 0, err
+	}
+	n, ok := v.(int64)
+	if !ok || n < 0 {
+		return 0, fmt.Errorf("invalid row count %v", v)
+	}
+	return int(n), nil
+}
+
+// sortCompare compares two values, NULL being the smallest one.
+func sortCompare(a, b any) (int, error) {
+	switch {
+	case a == nil && b == nil:
+		return 0, nil
+	case a == nil:
+		return -1, nil
+	case b == nil:
+		return 1, nil
+	default:
+		return compare(a, b)
+	}
+}
+
+// compare compares two values, the numbers by value and the strings as binary.
+func compare(a, b any) (int, error) {
+	_, ra := a.(*big.Rat)
+	_, rb := b.(*big.Rat)
+	if ra || rb {
+		x, err := toRat(a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := toRat(b)
+		if err != nil {
+			return 0, err
+		}
+		return x.Cmp(y), nil
+	}
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return cmp.Compare(x, y), nil
+		case uint64:
+			return -compareUint(y, x), nil
+		case float64:
+			return cmp.Compare(float64(x), y), nil
+		}
+	case uint64:
+		switch y := b.(type) {
+		case int64:
+			return compareUint(x, y), nil
+		case uint64:
+			return cmp.Compare(x, y), nil
+		case float64:
+			return cmp.Compare(float64(x), y), nil
+		}
+	case float64:
+		switch y := b.(type) {
+		case int64:
+			return cmp.Compare(x, float64(y)), nil
+		case uint64:
+			return cmp.Compare(x, float64(y)), nil
+		case float64:
+			return cmp.Compare(x, y), nil
+		}
+	case string:
+		switch y := b.(type) {
+		case string:
+			return strings.Compare(x, y), nil
+		case []byte:
+			return strings.Compare(x, string(y)), nil
+		}
+	case []byte:
+		switch y := b.(type) {
+		case []byte:
+			return bytes.Compare(x, y), nil
+		case string:
+			return strings.Compare(string(x), y), nil
+		}
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y), nil
+		}
+	case bool:
+		if y, ok := b.(bool); ok {
+			return cmp.Compare(boolInt(x), boolInt(y)), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare %T with %T", a, b)
+}
+
+func toRat(v any) (*big.Rat, error) {
+	switch x := v.(type) {
+	case *big.Rat:
+		return x, nil
+	case int64:
+		return new(big.Rat).SetInt64(x), nil
+	case uint64:
+		return new(big.Rat).SetUint64(x), nil
+	case float64:
+		if r := new(big.Rat).SetFloat64(x); r != nil {
+			return r, nil
+		}
+	case string:
+		if r, ok := new(big.Rat).SetString(x); ok {
+			return r, nil
+		}
+	case []byte:
+		if r, ok := new(big.Rat).SetString(string(x)); ok {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot cast %v as decimal", v)
+}
+
+// compareUint compares an unsigned integer with a signed one.
+func compareUint(x uint64, y int64) int {
+	if y < 0 {
+		return 1
+	}
+	return cmp.Compare(x, uint64(y))
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+// Package memsql provides an in-memory database/sql driver, to test the statements built by the cursor package
+// end to end, without MySQL server.
+//
+// It understands the narrow subset of SQL generated by the Statement: a single table SELECT, optionally wrapped
+// in a WITH clause to reverse the order of the rows, filtered by conditions on its columns (comparisons,
+// BETWEEN, IS [NOT] NULL, AND, OR, NOT, TRUE and FALSE), then ordered and limited with LIMIT and OFFSET.
+// The values are compared as binary, NULL being the smallest value when the rows are sorted.
+// The conditions follow the three-valued logic of SQL: any comparison with NULL is unknown, as its negation,
+// and only the rows whose condition is true are selected.
+package memsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+)
+
+var errNotSupported = errors.New("not supported")
+
+// Table is an in-memory table.
+type Table struct {
+	// Name is the name of the table.
+	Name string
+	// Columns are the names of the columns.
+	Columns []string
+	// Rows are the values of each row, in the order of the columns.
+	Rows [][]any
+}
+
+// Open returns a database with these tables.
+// The values of the rows are converted to driver values, such as int64 for an int.
+func Open(tables ...Table) (*sql.DB, error) {
+	c := connector{tables: make(map[string]*table, len(tables))}
+	for _, t := range tables {
+		rows := make([][]driver.Value, len(t.Rows))
+		for k, r := range t.Rows {
+			if len(r) != len(t.Columns) {
+				return nil, fmt.Errorf("memsql: %s: row %d: got %d values, exp %d", t.Name, k, len(r), len(t.Columns))
+			}
+			rows[k] = make([]driver.Value, len(r))
+			for i, v := range r {
+				dv, err := convert(v)
+				if err != nil {
+					return nil, fmt.Errorf("memsql: %s: row %d: %s: %w", t.Name, k, t.Columns[i], err)
+				}
+				rows[k][i] = dv
+			}
+		}
+		c.tables[t.Name] = &table{columns: slices.Clone(t.Columns), rows: rows}
+	}
+	return sql.OpenDB(c), nil
+}
+
+type table struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+// column returns the index of the column, the table prefix being ignored.
+func (t *table) column(name string) (int, error) {
+	for k, c := range t.columns {
+		if c == name || c == unqualified(name) {
+			return k, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown column %q", name)
+}
+
+// convert converts the value to a driver value, keeping the unsigned integers as uint64, as does MySQL's driver.
+func convert(v any) (driver.Value, error) {
+	if _, ok := v.(driver.Valuer); !ok && v != nil {
+		switch rv := reflect.ValueOf(v); rv.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return rv.Uint(), nil
+		}
+	}
+	return driver.DefaultParameterConverter.ConvertValue(v)
+}
+
+type connector struct {
+	tables map[string]*table
+}
+
+// Connect implements the driver.Connector interface.
+func (c connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{tables: c.tables}, nil
+}
+
+// Driver implements the driver.Connector interface.
+func (c connector) Driver() driver.Driver {
+	return drv{c: c}
+}
+
+type drv struct {
+	c connector
+}
+
+// Open implements the driver.Driver interface.
+func (d drv) Open(string) (driver.Conn, error) {
+	return d.c.Connect(context.Background())
+}
+
+type conn struct {
+	tables map[string]*table
+}
+
+// Begin implements the driver.Conn interface.
+func (c *conn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("memsql: transaction: %w", errNotSupported)
+}
+
+// CheckNamedValue implements the driver.NamedValueChecker interface, to accept uint64 arguments.
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	v, err := convert(nv.Value)
+	if err != nil {
+		return err
+	}
+	nv.Value = v
+	return nil
+}
+
+// Close implements the driver.Conn interface.
+func (c *conn) Close() error {
+	return nil
+}
+
+// Prepare implements the driver.Conn interface.
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	q, err := parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("memsql: %w", err)
+	}
+	return &stmt{conn: c, query: q}, nil
+}
+
+type stmt struct {
+	conn  *conn
+	query *selectStmt
+}
+
+// Close implements the driver.Stmt interface.
+func (s *stmt) Close() error {
+	return nil
+}
+
+// Exec implements the driver.Stmt interface.
+func (s *stmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, fmt.Errorf("memsql: exec: %w", errNotSupported)
+}
+
+// NumInput implements the driver.Stmt interface.
+func (s *stmt) NumInput() int {
+	return s.query.params
+}
+
+// Query implements the driver.Stmt interface.
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	t, err := s.query.run(s.conn.tables, args)
+	if err != nil {
+		return nil, fmt.Errorf("memsql: %w", err)
+	}
+	return &rows{table: t}, nil
+}
+
+type rows struct {
+	table *table
+	pos   int
+}
+
+// Close implements the driver.Rows interface.
+func (r *rows) Close() error {
+	return nil
+}
+
+// Columns implements the driver.Rows interface.
+func (r *rows) Columns() []string {
+	return r.table.columns
+}
+
+// Next implements the driver.Rows interface.
+func (r *rows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.table.rows) {
+		return io.EOF
+	}
+	copy(dest, r.table.rows[r.pos])
+	r.pos++
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package memsql_test
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rvflash/cursor/internal/memsql"
+)
+
+var users = memsql.Table{
+	Name:    "users",
+	Columns: []string{"id", "name", "score", "deleted_at"},
+	Rows: [][]any{
+		{1, "alice", 10.5, nil},
+		{2, "bob", 9.75, "2025-11-02"},
+		{3, "carol", nil, nil},
+		{4, "dave", 12, nil},
+		{5, "eve", 10.5, nil},
+	},
+}
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	_, err := memsql.Open(memsql.Table{Name: "t", Columns: []string{"id"}, Rows: [][]any{{1, 2}}})
+	checkErr(t, err, "memsql: t: row 0: got 2 values, exp 1")
+	_, err = memsql.Open(memsql.Table{Name: "t", Columns: []string{"id"}, Rows: [][]any{{struct{}{}}}})
+	checkErr(t, err, "memsql: t: row 0: id: unsupported type")
+}
+
+func TestDB_QueryContext(t *testing.T) {
+	t.Parallel()
+
+	db, err := memsql.Open(users)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	for name, tc := range map[string]struct {
+		// inputs
+		query string
+		args  []any
+		// outputs
+		out []int64
+		msg string
+	}{
+		"All":         {query: "SELECT id FROM users", out: []int64{1, 2, 3, 4, 5}},
+		"Star":        {query: "SELECT * FROM users u WHERE u.id = 2", out: []int64{2}},
+		"Order":       {query: "SELECT id FROM users ORDER BY id DESC", out: []int64{5, 4, 3, 2, 1}},
+		"Limit":       {query: "SELECT id FROM users ORDER BY id ASC LIMIT ? OFFSET ?", args: []any{2, 1}, out: []int64{2, 3}},
+		"Next":        {query: "SELECT id FROM users WHERE id >= ? ORDER BY id ASC LIMIT ?", args: []any{3, 3}, out: []int64{3, 4, 5}},
+		"Null":        {query: "SELECT id FROM users WHERE (deleted_at IS NULL) AND id < ? ORDER BY id DESC", args: []any{4}, out: []int64{3, 1}},
+		"Not null":    {query: "SELECT id FROM users WHERE deleted_at IS NOT NULL", out: []int64{2}},
+		"Between":     {query: "SELECT id FROM users WHERE id BETWEEN ? AND ?", args: []any{2, 4}, out: []int64{2, 3, 4}},
+		"Boolean":     {query: "SELECT id FROM users WHERE TRUE AND NOT FALSE AND name <> 'bob' LIMIT 2", out: []int64{1, 3}},
+		"Not":         {query: "SELECT id FROM users WHERE NOT (score > ?)", args: []any{10}, out: []int64{2}},
+		"Not unknown": {query: "SELECT id FROM users WHERE NOT (score > NULL)"},
+		"Unknown":     {query: "SELECT id FROM users WHERE score > ? OR NOT (score < ? AND name = 'carol')", args: []any{12, 0}, out: []int64{1, 2, 4, 5}},
+		"String":      {query: "SELECT id FROM users WHERE name > ? OR name = 'alice'", args: []any{"dave"}, out: []int64{1, 5}},
+		"Decimal": {
+			query: "SELECT id FROM users WHERE score >= CAST(? AS DECIMAL(65,30)) ORDER BY score DESC, id ASC",
+			args:  []any{"10.5"},
+			out:   []int64{4, 1, 5},
+		},
+		"Unsigned":    {query: "SELECT id FROM users WHERE id < ? AND id > ?", args: []any{uint64(math.MaxUint64), uint8(3)}, out: []int64{4, 5}},
+		"Nulls first": {query: "SELECT id FROM users ORDER BY score ASC, id ASC LIMIT 2", out: []int64{3, 2}},
+		"Reversed": {
+			query: "WITH d AS (SELECT id FROM users WHERE id < ? ORDER BY id DESC LIMIT ?) SELECT id FROM d ORDER BY id ASC;",
+			args:  []any{5, 2},
+			out:   []int64{3, 4},
+		},
+		"Unknown table":  {query: "SELECT id FROM groups", msg: `memsql: unknown table "groups"`},
+		"Unknown column": {query: "SELECT id FROM users ORDER BY age", msg: `memsql: unknown column "age"`},
+		"Mismatch":       {query: "SELECT id FROM users WHERE name > 1", msg: "memsql: cannot compare string with int64"},
+		"Syntax":         {query: "SELECT id FROM users WHERE id", msg: `memsql: unexpected "", expecting comparison operator`},
+		"Trailing":       {query: "SELECT id FROM users LIMIT 1 2", msg: `memsql: unexpected "2"`},
+		"Character":      {query: "SELECT id FROM users WHERE id ! 1", msg: "memsql: unexpected character '!'"},
+		"Unterminated":   {query: "SELECT id FROM users WHERE name = 'bob", msg: "memsql: unterminated string"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := queryIDs(context.Background(), db, tc.query, tc.args...)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestDB_ExecContext(t *testing.T) {
+	t.Parallel()
+
+	db, err := memsql.Open(users)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.ExecContext(context.Background(), "SELECT id FROM users")
+	checkErr(t, err, "memsql: exec: not supported")
+	_, err = db.BeginTx(context.Background(), nil)
+	checkErr(t, err, "memsql: transaction: not supported")
+}
+
+func queryIDs(ctx context.Context, db *sql.DB, query string, args ...any) ([]int64, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var res []int64
+	for rows.Next() {
+		cols, err := rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		dest := make([]any, len(cols))
+		var id int64
+		dest[0] = &id
+		for k := 1; k < len(dest); k++ {
+			dest[k] = new(any)
+		}
+		err = rows.Scan(dest...)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, id)
+	}
+	return res, rows.Err()
+}
+
+func checkErr(t *testing.T, err error, substr string) {
+	t.Helper()
+
+	if substr == "" {
+		t.Errorf("unexpected error: %s", err.Error())
+	} else if err == nil {
+		t.Errorf("got = nil, exp error: %s", substr)
+	} else if !strings.Contains(err.Error(), substr) {
+		t.Errorf("got = %s, exp = %s", err.Error(), substr)
+	}
+}
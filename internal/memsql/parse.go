@@ -0,0 +1,441 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package memsql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	identToken
+	numberToken
+	stringToken
+	paramToken
+	symbolToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// lex splits the query into tokens.
+func lex(query string) ([]token, error) {
+	var (
+		res []token
+		src = []rune(query)
+	)
+	for k := 0; k < len(src); {
+		r := src[k]
+		switch {
+		case unicode.IsSpace(r):
+			k++
+		case r == '?':
+			res = append(res, token{kind: paramToken, text: "?"})
+			k++
+		case r == '\'':
+			var (
+				buf = new(strings.Builder)
+				end = false
+			)
+			for k++; k < len(src); k++ {
+				if src[k] == '\'' {
+					if k+1 < len(src) && src[k+1] == '\'' {
+						buf.WriteRune('\'')
+						k++
+						continue
+					}
+					end = true
+					k++
+					break
+				}
+				buf.WriteRune(src[k])
+			}
+			if !end {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			res = append(res, token{kind: stringToken, text: buf.String()})
+		case unicode.IsDigit(r) || (r == '-' && k+1 < len(src) && unicode.IsDigit(src[k+1])):
+			i := k + 1
+			for i < len(src) && (unicode.IsDigit(src[i]) || src[i] == '.') {
+				i++
+			}
+			res = append(res, token{kind: numberToken, text: string(src[k:i])})
+			k = i
+		case unicode.IsLetter(r) || r == '_' || r == '`':
+			i := k + 1
+			for i < len(src) && (unicode.IsLetter(src[i]) || unicode.IsDigit(src[i]) || strings.ContainsRune("_.`", src[i])) {
+				i++
+			}
+			res = append(res, token{kind: identToken, text: strings.ReplaceAll(string(src[k:i]), "`", "")})
+			k = i
+		case strings.ContainsRune("<>!", r) && k+1 < len(src) && (src[k+1] == '=' || (r == '<' && src[k+1] == '>')):
+			res = append(res, token{kind: symbolToken, text: string(src[k : k+2])})
+			k += 2
+		case strings.ContainsRune("(),*=<>;", r):
+			res = append(res, token{kind: symbolToken, text: string(r)})
+			k++
+		default:
+			return nil, fmt.Errorf("unexpected character %q", r)
+		}
+	}
+	return append(res, token{kind: eofToken}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	params int
+}
+
+// parse parses the SELECT statement, optionally preceded by a WITH clause.
+func parse(query string) (*selectStmt, error) {
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tokens}
+	var with *cte
+	if p.keyword("WITH") {
+		with = &cte{}
+		with.name, err = p.ident()
+		if err != nil {
+			return nil, err
+		}
+		err = p.expectKeyword("AS")
+		if err != nil {
+			return nil, err
+		}
+		err = p.expect("(")
+		if err != nil {
+			return nil, err
+		}
+		with.query, err = p.parseSelect()
+		if err != nil {
+			return nil, err
+		}
+		err = p.expect(")")
+		if err != nil {
+			return nil, err
+		}
+	}
+	s, err := p.parseSelect()
+	if err != nil {
+		return nil, err
+	}
+	p.symbol(";")
+	if t := p.peek(); t.kind != eofToken {
+		return nil, fmt.Errorf("unexpected %q", t.text)
+	}
+	s.with = with
+	s.params = p.params
+	return s, nil
+}
+
+func (p *parser) parseSelect() (*selectStmt, error) {
+	err := p.expectKeyword("SELECT")
+	if err != nil {
+		return nil, err
+	}
+	var s selectStmt
+	if !p.symbol("*") {
+		for {
+			col, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			s.columns = append(s.columns, col)
+			if !p.symbol(",") {
+				break
+			}
+		}
+	}
+	err = p.expectKeyword("FROM")
+	if err != nil {
+		return nil, err
+	}
+	s.from, err = p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind == identToken && !isKeyword(t.text) {
+		// Alias of the table.
+		p.pos++
+	}
+	if p.keyword("WHERE") {
+		s.where, err = p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.keyword("ORDER") {
+		err = p.expectKeyword("BY")
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var o order
+			o.column, err = p.ident()
+			if err != nil {
+				return nil, err
+			}
+			if p.keyword("DESC") {
+				o.desc = true
+			} else {
+				p.keyword("ASC")
+			}
+			s.orderBy = append(s.orderBy, o)
+			if !p.symbol(",") {
+				break
+			}
+		}
+	}
+	if p.keyword("LIMIT") {
+		s.limit, err = p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if p.keyword("OFFSET") {
+			s.offset, err = p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &s, nil
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (expr, error) {
+	if p.keyword("NOT") {
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: e}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	switch {
+	case p.symbol("("):
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expect(")")
+	case p.keyword("TRUE"):
+		return boolExpr(true), nil
+	case p.keyword("FALSE"):
+		return boolExpr(false), nil
+	}
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.keyword("IS"):
+		not := p.keyword("NOT")
+		return isNullExpr{operand: left, not: not}, p.expectKeyword("NULL")
+	case p.keyword("BETWEEN"):
+		lower, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		err = p.expectKeyword("AND")
+		if err != nil {
+			return nil, err
+		}
+		upper, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return betweenExpr{operand: left, lower: lower, upper: upper}, nil
+	}
+	t := p.peek()
+	switch t.text {
+	case "=", "!=", "<>", "<", "<=", ">", ">=":
+		p.pos++
+	default:
+		return nil, fmt.Errorf("unexpected %q, expecting comparison operator", t.text)
+	}
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return cmpExpr{op: t.text, left: left, right: right}, nil
+}
+
+func (p *parser) parseOperand() (operand, error) {
+	t := p.peek()
+	switch t.kind {
+	case paramToken:
+		p.pos++
+		p.params++
+		return param(p.params - 1), nil
+	case stringToken:
+		p.pos++
+		return literal{v: t.text}, nil
+	case numberToken:
+		p.pos++
+		return parseNumber(t.text)
+	case identToken:
+		switch {
+		case strings.EqualFold(t.text, "NULL"):
+			p.pos++
+			return literal{}, nil
+		case strings.EqualFold(t.text, "CAST"):
+			p.pos++
+			return p.parseCast()
+		case isKeyword(t.text):
+			return nil, fmt.Errorf("unexpected %q, expecting operand", t.text)
+		}
+		p.pos++
+		return column(t.text), nil
+	default:
+		return nil, fmt.Errorf("unexpected %q, expecting operand", t.text)
+	}
+}
+
+// parseCast parses: CAST(operand AS DECIMAL[(precision, scale)]).
+func (p *parser) parseCast() (operand, error) {
+	err := p.expect("(")
+	if err != nil {
+		return nil, err
+	}
+	o, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	err = p.expectKeyword("AS")
+	if err != nil {
+		return nil, err
+	}
+	err = p.expectKeyword("DECIMAL")
+	if err != nil {
+		return nil, err
+	}
+	if p.symbol("(") {
+		for p.peek().kind == numberToken || p.peek().text == "," {
+			p.pos++
+		}
+		err = p.expect(")")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return decimal{operand: o}, p.expect(")")
+}
+
+func parseNumber(s string) (operand, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return literal{v: n}, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	return literal{v: f}, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) keyword(name string) bool {
+	if t := p.peek(); t.kind == identToken && strings.EqualFold(t.text, name) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) symbol(s string) bool {
+	if t := p.peek(); t.kind == symbolToken && t.text == s {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectKeyword(name string) error {
+	if !p.keyword(name) {
+		return fmt.Errorf("unexpected %q, expecting %s", p.peek().text, name)
+	}
+	return nil
+}
+
+func (p *parser) expect(s string) error {
+	if !p.symbol(s) {
+		return fmt.Errorf("unexpected %q, expecting %q", p.peek().text, s)
+	}
+	return nil
+}
+
+func (p *parser) ident() (string, error) {
+	t := p.peek()
+	if t.kind != identToken || isKeyword(t.text) {
+		return "", fmt.Errorf("unexpected %q, expecting identifier", t.text)
+	}
+	p.pos++
+	return t.text, nil
+}
+
+var keywords = []string{
+	"AND", "AS", "ASC", "BETWEEN", "BY", "CAST", "DESC", "FALSE", "FROM", "IS", "LIMIT",
+	"NOT", "NULL", "OFFSET", "OR", "ORDER", "SELECT", "TRUE", "WHERE", "WITH",
+}
+
+func isKeyword(s string) bool {
+	for _, k := range keywords {
+		if strings.EqualFold(s, k) {
+			return true
+		}
+	}
+	return false
+}
+
+func unqualified(name string) string {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+var errMissingCursor = errors.New("missing cursor")
+
+// Fetcher returns the rows of the page of the statement in display order, and updates its cursor with them.
+// For example, by using Query.
+type Fetcher[T Pointer, R any] func(ctx context.Context, st Statement[T]) ([]R, error)
+
+// All returns an iterator over the rows of all the pages, starting from the page of the statement's cursor.
+// See Pages for details.
+func All[T Pointer, R any](ctx context.Context, st Statement[T], fetch Fetcher[T, R]) iter.Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		for rows, err := range Pages(ctx, st, fetch) {
+			if err != nil {
+				var zero R
+				yield(zero, err)
+				return
+			}
+			for _, r := range rows {
+				if !yield(r, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Pages returns an iterator over the pages, starting from the page of the statement's cursor, going forward.
+// It stops after the last page, or with an error if the fetch fails or the context is done.
+// Once a page is consumed, when the iteration goes on, the statement's cursor is updated with the cursor
+// of this page, so that an interrupted job can be resumed with its next cursor.
+func Pages[T Pointer, R any](ctx context.Context, st Statement[T], fetch Fetcher[T, R]) iter.Seq2[[]R, error] {
+	return func(yield func([]R, error) bool) {
+		if st.Cursor == nil {
+			yield(nil, errMissingCursor)
+			return
+		}
+		cur := *st.Cursor
+		for {
+			err := ctx.Err()
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			page := cur
+			rows, err := fetch(ctx, Statement[T]{Cursor: &page, DescendingOrder: st.DescendingOrder})
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if len(rows) == 0 {
+				return
+			}
+			if !yield(rows, nil) {
+				return
+			}
+			*st.Cursor = page
+			next := Next(&page)
+			if next == nil {
+				return
+			}
+			cur = *next
+		}
+	}
+}
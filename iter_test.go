@@ -0,0 +1,149 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/rvflash/cursor"
+)
+
+var ids = []int64{1, 2, 3, 4, 5}
+
+// fetchIDs returns the page of ids, going forward.
+func fetchIDs(_ context.Context, st cursor.Statement[cursor.Int64]) ([]int64, error) {
+	c := st.Cursor
+	var start int
+	if c.Next != nil {
+		start, _ = slices.BinarySearch(ids, int64(*c.Next))
+	}
+	rows := ids[start:min(len(ids), start+st.Limit())]
+	c.Reset()
+	for _, v := range rows {
+		c.Add(cursor.Int64(v))
+	}
+	return rows[:min(len(rows), c.Limit)], nil
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		oops     = errors.New("oops")
+		canceled = func() context.Context {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			return ctx
+		}
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		ctx   context.Context
+		c     *cursor.Cursor[cursor.Int64]
+		fetch cursor.Fetcher[cursor.Int64, int64]
+		stop  int
+		// outputs
+		out    []int64
+		offset int
+		err    error
+	}{
+		"Default": {ctx: context.Background(), fetch: fetchIDs, err: errors.New("missing cursor")},
+		"Canceled": {
+			ctx:   canceled(),
+			c:     cursor.New[cursor.Int64](limit, 0),
+			fetch: fetchIDs,
+			err:   context.Canceled,
+		},
+		"Failure": {
+			ctx: context.Background(),
+			c:   cursor.New[cursor.Int64](limit, 0),
+			fetch: func(context.Context, cursor.Statement[cursor.Int64]) ([]int64, error) {
+				return nil, oops
+			},
+			err: oops,
+		},
+		"Stopped": {
+			ctx:    context.Background(),
+			c:      cursor.New[cursor.Int64](limit, 0),
+			fetch:  fetchIDs,
+			stop:   4,
+			out:    []int64{1, 2, 3, 4},
+			offset: 0,
+		},
+		"OK": {
+			ctx:    context.Background(),
+			c:      cursor.New[cursor.Int64](limit, 0),
+			fetch:  fetchIDs,
+			out:    ids,
+			offset: limit * 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				out []int64
+				err error
+			)
+			for r, e := range cursor.All(tc.ctx, cursor.Statement[cursor.Int64]{Cursor: tc.c}, tc.fetch) {
+				if e != nil {
+					err = e
+					break
+				}
+				if out = append(out, r); len(out) == tc.stop {
+					break
+				}
+			}
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+			if tc.err != nil {
+				checkErr(t, err, tc.err.Error())
+				return
+			}
+			if err != nil {
+				checkErr(t, err, "")
+			}
+			if tc.c.Offset != tc.offset {
+				t.Errorf("\ngot %d\nexp %d", tc.c.Offset, tc.offset)
+			}
+		})
+	}
+}
+
+func TestPages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		c   = cursor.New[cursor.Int64](limit, 0)
+		out [][]int64
+	)
+	for rows, err := range cursor.Pages(context.Background(), cursor.Statement[cursor.Int64]{Cursor: c}, fetchIDs) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out = append(out, rows); len(out) == limit {
+			break
+		}
+	}
+	if exp := [][]int64{{1, 2}, {3, 4}}; !reflect.DeepEqual(out, exp) {
+		t.Errorf("\ngot %#v\nexp %#v", out, exp)
+	}
+	// Resumes the job from the last finished page, the second one being interrupted.
+	out = nil
+	for rows, err := range cursor.Pages(context.Background(), cursor.Statement[cursor.Int64]{Cursor: cursor.Next(c)}, fetchIDs) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, rows)
+	}
+	if exp := [][]int64{{3, 4}, {5}}; !reflect.DeepEqual(out, exp) {
+		t.Errorf("\ngot %#v\nexp %#v", out, exp)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+const (
+	tagName = "cursor"
+	tagAsc  = "asc"
+	tagDesc = "desc"
+	tagSkip = "-"
+)
+
+// fields caches the tagged fields by struct type.
+var fields sync.Map
+
+// KeyOf returns the Key of this row, based on the values of its fields tagged with `cursor`.
+// It panics if R is not a struct with at least one tagged field.
+func KeyOf[R any](row R) Key[R] {
+	var (
+		v = reflect.ValueOf(row)
+		f = keyFields[R]()
+		k = Key[R]{values: make([]any, len(f))}
+	)
+	for i := range f {
+		k.values[i] = v.FieldByIndex(f[i].index).Interface()
+	}
+	return k
+}
+
+// Key is a Pointer built from the fields of the struct R tagged with `cursor`.
+// The tag defines the column name, optionally followed by its sort order, asc by default, or desc:
+//
+//	type User struct {
+//		CreatedAt time.Time `cursor:"created_at,desc"`
+//		ID        int64     `cursor:"id"`
+//		Name      string
+//	}
+//
+// The order of the fields defines the order of the columns.
+// Key implements the Sorter interface, so Statement uses these columns and sort orders.
+type Key[R any] struct {
+	values []any
+}
+
+// Args implements the Pointer interface.
+func (k Key[R]) Args() []any {
+	if k.IsZero() {
+		return nil
+	}
+	return k.values
+}
+
+// Columns implements the Sorter interface.
+func (k Key[R]) Columns() []Column {
+	var (
+		f = keyFields[R]()
+		c = make([]Column, len(f))
+	)
+	for i := range f {
+		c[i] = f[i].column
+	}
+	return c
+}
+
+// Compare implements the Comparer interface.
+// Keys are compared lexicographically, following the sort order of each column.
+func (k Key[R]) Compare(other Pointer) int {
+	var (
+		o = mustBe[Key[R]](k, other)
+		f = keyFields[R]()
+	)
+	if len(k.values) != len(o.values) {
+		// At least one zero key.
+		return cmp.Compare(len(k.values), len(o.values))
+	}
+	for i := range k.values {
+		c := compareValues(k.values[i], o.values[i])
+		if f[i].column.Desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// IsZero implements the Pointer interface.
+func (k Key[R]) IsZero() bool {
+	for i := range k.values {
+		if k.values[i] != nil && !reflect.ValueOf(k.values[i]).IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The values are encoded as a JSON array.
+func (k Key[R]) MarshalJSON() ([]byte, error) {
+	if k.values == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(k.values)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Each value is decoded with the type of its field.
+func (k *Key[R]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var raw []json.RawMessage
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return fmt.Errorf("key: %w", err)
+	}
+	if len(raw) == 0 {
+		*k = Key[R]{}
+		return nil
+	}
+	f := keyFields[R]()
+	if len(raw) != len(f) {
+		return fmt.Errorf("key: got %d values, exp %d", len(raw), len(f))
+	}
+	v := Key[R]{values: make([]any, len(f))}
+	for i := range f {
+		p := reflect.New(f[i].typ)
+		err = json.Unmarshal(raw[i], p.Interface())
+		if err != nil {
+			return fmt.Errorf("key: %s: %w", f[i].column.Name, err)
+		}
+		v.values[i] = p.Elem().Interface()
+	}
+	*k = v
+	return nil
+}
+
+type keyField struct {
+	index  []int
+	typ    reflect.Type
+	column Column
+}
+
+func keyFields[R any]() []keyField {
+	typ := reflect.TypeFor[R]()
+	if f, ok := fields.Load(typ); ok {
+		return f.([]keyField)
+	}
+	f, err := parseKeyFields(typ)
+	if err != nil {
+		panic("cursor: " + err.Error())
+	}
+	fields.Store(typ, f)
+	return f
+}
+
+func parseKeyFields(typ reflect.Type) ([]keyField, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a struct", typ)
+	}
+	var res []keyField
+	for i := range typ.NumField() {
+		f := typ.Field(i)
+		tag, ok := f.Tag.Lookup(tagName)
+		if !ok || tag == tagSkip {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, fmt.Errorf("%s.%s: unexported field", typ, f.Name)
+		}
+		name, opt, _ := strings.Cut(tag, ",")
+		if name == "" {
+			return nil, fmt.Errorf("%s.%s: missing column name", typ, f.Name)
+		}
+		c := Column{Name: name}
+		switch opt {
+		case "", tagAsc:
+		case tagDesc:
+			c.Desc = true
+		default:
+			return nil, fmt.Errorf("%s.%s: unknown sort order %q", typ, f.Name, opt)
+		}
+		res = append(res, keyField{index: f.Index, typ: f.Type, column: c})
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("%s has no field tagged with %s", typ, tagName)
+	}
+	return res, nil
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rvflash/cursor"
+)
+
+type user struct {
+	CreatedAt time.Time `cursor:"created_at,desc"`
+	ID        int64     `cursor:"id"`
+	Name      string
+}
+
+var (
+	alice = user{CreatedAt: time.Date(2025, 10, 30, 16, 17, 12, 0, time.UTC), ID: p3DescKey, Name: "Alice"}
+	bob   = user{CreatedAt: time.Date(2025, 10, 31, 11, 38, 37, 0, time.UTC), ID: p1DescKey, Name: "Bob"}
+)
+
+func TestKeyOf(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		fn  func()
+		msg string
+	}{
+		"Not a struct": {
+			fn:  func() { cursor.KeyOf(1) },
+			msg: "cursor: int is not a struct",
+		},
+		"No tag": {
+			fn:  func() { cursor.KeyOf(struct{ ID int64 }{}) },
+			msg: "cursor: struct { ID int64 } has no field tagged with cursor",
+		},
+		"Unknown order": {
+			fn: func() {
+				cursor.KeyOf(struct {
+					ID int64 `cursor:"id,down"`
+				}{})
+			},
+			msg: `cursor: struct { ID int64 "cursor:\"id,down\"" }.ID: unknown sort order "down"`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if r := recover(); r != tc.msg {
+					t.Errorf("\ngot %v\nexp %s", r, tc.msg)
+				}
+			}()
+			tc.fn()
+		})
+	}
+}
+
+func TestKey_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Key[user]
+		out []any
+	}{
+		"Default": {},
+		"Zero":    {in: cursor.KeyOf(user{Name: "Bob"})},
+		"OK":      {in: cursor.KeyOf(alice), out: []any{alice.CreatedAt, alice.ID}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestKey_Columns(t *testing.T) {
+	t.Parallel()
+
+	var (
+		out = cursor.Key[user]{}.Columns()
+		exp = []cursor.Column{{Name: "created_at", Desc: true}, {Name: "id"}}
+	)
+	if !reflect.DeepEqual(out, exp) {
+		t.Errorf("\ngot %#v\nexp %#v", out, exp)
+	}
+}
+
+func TestKey_IsZero(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Key[user]
+		out bool
+	}{
+		"Default": {out: true},
+		"Zero":    {in: cursor.KeyOf(user{Name: "Bob"}), out: true},
+		"OK":      {in: cursor.KeyOf(alice)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.IsZero()
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestKey_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out cursor.Key[user]
+		msg string
+	}{
+		"Default": {msg: "unexpected end of JSON input"},
+		"Blank":   {in: `[]`},
+		"Arity":   {in: `[1]`, msg: "key: got 1 values, exp 2"},
+		"Type":    {in: `["2025-10-30T16:17:12Z","1"]`, msg: "key: id: json: cannot unmarshal string"},
+		"OK":      {in: `["2025-10-30T16:17:12Z",52352]`, out: cursor.KeyOf(alice)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out cursor.Key[user]
+			err := json.Unmarshal([]byte(tc.in), &out)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestStatement_Key(t *testing.T) {
+	t.Parallel()
+
+	var (
+		a = cursor.KeyOf(alice)
+		b = cursor.KeyOf(bob)
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		in   cursor.Statement[cursor.Key[user]]
+		cols []string
+		// outputs
+		order string
+		where string
+		args  []any
+	}{
+		"First page": {
+			in: cursor.Statement[cursor.Key[user]]{
+				Cursor: &cursor.Cursor[cursor.Key[user]]{Limit: limit},
+			},
+			order: " created_at DESC, id ASC",
+		},
+		"Next page": {
+			in: cursor.Statement[cursor.Key[user]]{
+				Cursor: &cursor.Cursor[cursor.Key[user]]{Limit: limit, Next: &a},
+			},
+			order: " created_at DESC, id ASC",
+			where: " AND (created_at < ? OR (created_at = ? AND id >= ?))",
+			args:  []any{alice.CreatedAt, alice.CreatedAt, alice.ID},
+		},
+		"Prev page": {
+			in: cursor.Statement[cursor.Key[user]]{
+				Cursor: &cursor.Cursor[cursor.Key[user]]{Limit: limit, Prev: &b},
+			},
+			order: " created_at ASC, id DESC",
+			where: " AND (created_at > ? OR (created_at = ? AND id < ?))",
+			args:  []any{bob.CreatedAt, bob.CreatedAt, bob.ID},
+		},
+		"Last page": {
+			in: cursor.Statement[cursor.Key[user]]{
+				Cursor: &cursor.Cursor[cursor.Key[user]]{Limit: limit, Next: new(cursor.Key[user])},
+			},
+			order: " created_at ASC, id DESC",
+		},
+		"Descending - Next page": {
+			in: cursor.Statement[cursor.Key[user]]{
+				Cursor:          &cursor.Cursor[cursor.Key[user]]{Limit: limit, Next: &a},
+				DescendingOrder: true,
+			},
+			order: " created_at ASC, id DESC",
+			where: " AND (created_at > ? OR (created_at = ? AND id <= ?))",
+			args:  []any{alice.CreatedAt, alice.CreatedAt, alice.ID},
+		},
+		"Columns": {
+			in: cursor.Statement[cursor.Key[user]]{
+				Cursor: &cursor.Cursor[cursor.Key[user]]{Limit: limit, Next: &a},
+			},
+			cols:  []string{"created_at", "id"},
+			order: " created_at DESC, id ASC",
+			where: " AND (created_at < ? OR (created_at = ? AND id >= ?))",
+			args:  []any{alice.CreatedAt, alice.CreatedAt, alice.ID},
+		},
+		"Qualified columns": {
+			in: cursor.Statement[cursor.Key[user]]{
+				Cursor: &cursor.Cursor[cursor.Key[user]]{Limit: limit, Prev: &b},
+			},
+			cols:  []string{"u.created_at", "u.id"},
+			order: " u.created_at ASC, u.id DESC",
+			where: " AND (u.created_at > ? OR (u.created_at = ? AND u.id < ?))",
+			args:  []any{bob.CreatedAt, bob.CreatedAt, bob.ID},
+		},
+		"Mismatched columns": {
+			in: cursor.Statement[cursor.Key[user]]{
+				Cursor: &cursor.Cursor[cursor.Key[user]]{Limit: limit, Next: &a},
+			},
+			cols:  []string{"id"},
+			order: " created_at DESC, id ASC",
+			where: " AND (created_at < ? OR (created_at = ? AND id >= ?))",
+			args:  []any{alice.CreatedAt, alice.CreatedAt, alice.ID},
+		},
+		"Window": {
+			in: cursor.Statement[cursor.Key[user]]{
+				Cursor: &cursor.Cursor[cursor.Key[user]]{Limit: limit, Prev: &b, Next: &a, Window: true},
+			},
+			order: " created_at DESC, id ASC",
+			where: " AND (created_at < ? OR (created_at = ? AND id >= ?))" +
+				" AND (created_at > ? OR (created_at = ? AND id <= ?))",
+			args: []any{bob.CreatedAt, bob.CreatedAt, bob.ID, alice.CreatedAt, alice.CreatedAt, alice.ID},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if order := tc.in.OrderBy(tc.cols...); order != tc.order {
+				t.Errorf("\ngot %s\nexp %s", order, tc.order)
+			}
+			where, args := tc.in.WhereCondition(tc.cols...)
+			if where != tc.where {
+				t.Errorf("\ngot %s\nexp %s", where, tc.where)
+			}
+			if !reflect.DeepEqual(args, tc.args) {
+				t.Errorf("\ngot %#v\nexp %#v", args, tc.args)
+			}
+		})
+	}
+}
+
+func TestDecrypt_Key(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nxt = cursor.KeyOf(alice)
+		in  = &cursor.Cursor[cursor.Key[user]]{Limit: limit, Next: &nxt}
+	)
+	b, err := cursor.Encrypt(in, []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := cursor.Decrypt[cursor.Key[user]](b, []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out.Next, in.Next) {
+		t.Errorf("\ngot %#v\nexp %#v", out.Next, in.Next)
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// List of link relation types used to navigate.
+const (
+	RelFirst = "first"
+	RelPrev  = "prev"
+	RelNext  = "next"
+	RelLast  = "last"
+)
+
+const relParam = "rel"
+
+var errLink = errors.New("invalid link format")
+
+// ParseLinkHeader parses the value of a RFC 8288 Link header and returns the cursors
+// found in the param query parameter of the first, prev, next and last links.
+// The other links are ignored.
+func ParseLinkHeader(header, param string) (*Pagination, error) {
+	var p Pagination
+	for _, v := range splitLinks(header) {
+		u, rels, err := parseLink(v)
+		if err != nil {
+			return nil, fmt.Errorf("link: %w", err)
+		}
+		token := u.Query().Get(param)
+		for _, rel := range rels {
+			switch strings.ToLower(rel) {
+			case RelFirst:
+				p.First = token
+			case RelPrev, "previous":
+				p.Prev = token
+			case RelNext:
+				p.Next = token
+			case RelLast:
+				p.Last = token
+			}
+		}
+	}
+	return &p, nil
+}
+
+// LinkHeader returns the value of a RFC 8288 Link header with the links to navigate.
+// Each link is based on the base URL, with the cursor set as the param query parameter,
+// such as: <https://api.example.com/users?cursor=eyJ...>; rel="next".
+// It returns an empty string if there is no link.
+func (p *Pagination) LinkHeader(base *url.URL, param string) string {
+	if p == nil {
+		return ""
+	}
+	var links []string
+	for _, l := range []struct {
+		rel, token string
+	}{
+		{rel: RelFirst, token: p.First},
+		{rel: RelPrev, token: p.Prev},
+		{rel: RelNext, token: p.Next},
+		{rel: RelLast, token: p.Last},
+	} {
+		if l.token != "" {
+			links = append(links, fmt.Sprintf(`<%s>; %s="%s"`, pageURL(base, param, l.token), relParam, l.rel))
+		}
+	}
+	return strings.Join(links, ", ")
+}
+
+// URLs returns the absolute URLs to navigate, based on the URL of the request r,
+// with the cursor set as the param query parameter.
+// The scheme and host are taken from origin if not nil, to be used behind a proxy,
+// otherwise from the request: its TLS connection and its Host header.
+// The other query parameters are kept and sorted by key. The URLs of the unavailable cursors are empty.
+func (p *Pagination) URLs(r *http.Request, origin *url.URL, param string) *Links {
+	if p == nil {
+		return &Links{}
+	}
+	base := baseURL(r, origin)
+	link := func(token string) string {
+		if token == "" {
+			return ""
+		}
+		return pageURL(&base, param, token)
+	}
+	return &Links{
+		First: link(p.First),
+		Prev:  link(p.Prev),
+		Next:  link(p.Next),
+		Last:  link(p.Last),
+	}
+}
+
+// baseURL returns the absolute URL of the request, with the scheme and host of origin if not nil.
+func baseURL(r *http.Request, origin *url.URL) url.URL {
+	u := requestURL(r)
+	if origin != nil {
+		u.Scheme = origin.Scheme
+		u.Host = origin.Host
+	}
+	return u
+}
+
+// requestURL returns the absolute URL of the request, the URL of a server request having no scheme and host.
+func requestURL(r *http.Request) url.URL {
+	var u url.URL
+	if r == nil {
+		return u
+	}
+	if r.URL != nil {
+		u = *r.URL
+	}
+	if u.Host == "" {
+		u.Host = r.Host
+	}
+	if u.Scheme == "" {
+		u.Scheme = "http"
+		if r.TLS != nil {
+			u.Scheme = "https"
+		}
+	}
+	return u
+}
+
+// Links contains the URLs to navigate.
+type Links struct {
+	First string `json:"first,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+// pageURL returns the URL with the param query parameter set to the token, keeping the other ones.
+func pageURL(base *url.URL, param, token string) string {
+	var u url.URL
+	if base != nil {
+		u = *base
+	}
+	q := u.Query()
+	q.Set(param, token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// parseLink parses a link-value, such as: <https://api.example.com/users?cursor=eyJ...>; rel="next last".
+func parseLink(s string) (*url.URL, []string, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "<") {
+		return nil, nil, errLink
+	}
+	end := strings.IndexByte(s, '>')
+	if end < 0 {
+		return nil, nil, errLink
+	}
+	u, err := url.Parse(s[1:end])
+	if err != nil {
+		return nil, nil, err
+	}
+	var rels []string
+	for s = s[end+1:]; strings.TrimSpace(s) != ""; {
+		var name, value string
+		name, value, s, err = parseLinkParam(s)
+		if err != nil {
+			return nil, nil, err
+		}
+		if strings.EqualFold(name, relParam) && rels == nil {
+			// Occurrences after the first one must be ignored.
+			rels = strings.Fields(value)
+		}
+	}
+	return u, rels, nil
+}
+
+// parseLinkParam parses the next link-param, such as: ; rel="next", and returns the remaining string.
+func parseLinkParam(s string) (name, value, rest string, err error) {
+	s = strings.TrimLeft(s, " \t")
+	if !strings.HasPrefix(s, ";") {
+		return "", "", "", errLink
+	}
+	s = strings.TrimLeft(s[1:], " \t")
+	end := strings.IndexAny(s, "=;")
+	if end < 0 {
+		return strings.TrimSpace(s), "", "", nil
+	}
+	name = strings.TrimSpace(s[:end])
+	if name == "" {
+		return "", "", "", errLink
+	}
+	if s[end] == ';' {
+		return name, "", s[end:], nil
+	}
+	s = strings.TrimLeft(s[end+1:], " \t")
+	if !strings.HasPrefix(s, `"`) {
+		end = strings.IndexByte(s, ';')
+		if end < 0 {
+			return name, strings.TrimSpace(s), "", nil
+		}
+		return name, strings.TrimSpace(s[:end]), s[end:], nil
+	}
+	var b strings.Builder
+	for k := 1; k < len(s); k++ {
+		switch s[k] {
+		case '\\':
+			if k++; k < len(s) {
+				b.WriteByte(s[k])
+			}
+		case '"':
+			return name, b.String(), s[k+1:], nil
+		default:
+			b.WriteByte(s[k])
+		}
+	}
+	return "", "", "", errLink
+}
+
+// splitLinks splits the header on the commas separating the link-values,
+// ignoring the ones in the URI references and in the quoted strings.
+func splitLinks(header string) []string {
+	var (
+		res            []string
+		start          int
+		inURI, inQuote bool
+	)
+	for k := 0; k < len(header); k++ {
+		switch c := header[k]; {
+		case inQuote && c == '\\':
+			k++
+		case inQuote:
+			inQuote = c != '"'
+		case inURI:
+			inURI = c != '>'
+		case c == '<':
+			inURI = true
+		case c == '"':
+			inQuote = true
+		case c == ',':
+			res = appendLink(res, header[start:k])
+			start = k + 1
+		}
+	}
+	return appendLink(res, header[start:])
+}
+
+func appendLink(res []string, s string) []string {
+	if s = strings.TrimSpace(s); s != "" {
+		return append(res, s)
+	}
+	return res
+}
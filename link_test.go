@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/rvflash/cursor"
+)
+
+const (
+	tokenFirst = "eyJwcmV2IjowfQ.c2ln"
+	tokenNext  = "eyJuZXh0IjozfQ.c2ln"
+	tokenLast  = "eyJuZXh0IjowfQ.c2ln"
+)
+
+func TestParseLinkHeader(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out *cursor.Pagination
+		msg string
+	}{
+		"Default":     {out: &cursor.Pagination{}},
+		"No URI":      {in: `rel="next"`, msg: "link: invalid link format"},
+		"Unclosed":    {in: `<https://api.example.com/users; rel="next"`, msg: "link: invalid link format"},
+		"No param":    {in: `<https://api.example.com/users> rel="next"`, msg: "link: invalid link format"},
+		"Bad quoting": {in: `<https://api.example.com/users>; rel="next`, msg: "link: invalid link format"},
+		"Unknown relation": {
+			in:  `<https://api.example.com/users?cursor=` + tokenNext + `>; rel="related"`,
+			out: &cursor.Pagination{},
+		},
+		"Unquoted": {
+			in:  `<https://api.example.com/users?cursor=` + tokenNext + `>;rel=next`,
+			out: &cursor.Pagination{Next: tokenNext},
+		},
+		"Multiple relations": {
+			in:  `<https://api.example.com/users?cursor=` + tokenLast + `>; title="a, b; c"; rel="next LAST"`,
+			out: &cursor.Pagination{Next: tokenLast, Last: tokenLast},
+		},
+		"OK": {
+			in: `<https://api.example.com/users?cursor=` + tokenFirst + `&q=a,b>; rel="first", ` +
+				`<https://api.example.com/users?cursor=` + tokenNext + `>; rel="next"; rel="prev", ` +
+				`<https://api.example.com/users?cursor=` + tokenLast + `>; rel="last"`,
+			out: &cursor.Pagination{First: tokenFirst, Next: tokenNext, Last: tokenLast},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := cursor.ParseLinkHeader(tc.in, "cursor")
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestPagination_LinkHeader(t *testing.T) {
+	t.Parallel()
+
+	base, err := url.Parse("https://api.example.com/users?sort=name&cursor=old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, tc := range map[string]struct {
+		in  *cursor.Pagination
+		out string
+	}{
+		"Default": {},
+		"Blank":   {in: &cursor.Pagination{}},
+		"OK": {
+			in: &cursor.Pagination{First: tokenFirst, Next: tokenNext, Last: tokenLast},
+			out: `<https://api.example.com/users?cursor=` + tokenFirst + `&sort=name>; rel="first", ` +
+				`<https://api.example.com/users?cursor=` + tokenNext + `&sort=name>; rel="next", ` +
+				`<https://api.example.com/users?cursor=` + tokenLast + `&sort=name>; rel="last"`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.LinkHeader(base, "cursor")
+			if out != tc.out {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+			if out == "" {
+				return
+			}
+			back, err := cursor.ParseLinkHeader(out, "cursor")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(back, tc.in) {
+				t.Errorf("\ngot %#v\nexp %#v", back, tc.in)
+			}
+		})
+	}
+}
+
+func TestPagination_URLs(t *testing.T) {
+	t.Parallel()
+
+	origin, err := url.Parse("https://api.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, tc := range map[string]struct {
+		// inputs
+		in     *cursor.Pagination
+		r      *http.Request
+		origin *url.URL
+		// outputs
+		out *cursor.Links
+	}{
+		"Default": {out: &cursor.Links{}},
+		"Blank": {
+			in:     &cursor.Pagination{},
+			r:      httptest.NewRequest(http.MethodGet, "/users?sort=name&cursor=old&a=1", nil),
+			origin: origin,
+			out:    &cursor.Links{},
+		},
+		"Request": {
+			in: &cursor.Pagination{Next: tokenNext},
+			r:  httptest.NewRequest(http.MethodGet, "/users?sort=name&cursor=old&a=1", nil),
+			out: &cursor.Links{
+				Next: "http://example.com/users?a=1&cursor=" + tokenNext + "&sort=name",
+			},
+		},
+		"TLS": {
+			in: &cursor.Pagination{Next: tokenNext},
+			r:  httptest.NewRequest(http.MethodGet, "https://www.example.com/users?sort=name", nil),
+			out: &cursor.Links{
+				Next: "https://www.example.com/users?cursor=" + tokenNext + "&sort=name",
+			},
+		},
+		"OK": {
+			in:     &cursor.Pagination{First: tokenFirst, Prev: tokenFirst, Next: tokenNext, Last: tokenLast},
+			r:      httptest.NewRequest(http.MethodGet, "/users?sort=name&cursor=old&a=1", nil),
+			origin: origin,
+			out: &cursor.Links{
+				First: "https://api.example.com/users?a=1&cursor=" + tokenFirst + "&sort=name",
+				Prev:  "https://api.example.com/users?a=1&cursor=" + tokenFirst + "&sort=name",
+				Next:  "https://api.example.com/users?a=1&cursor=" + tokenNext + "&sort=name",
+				Last:  "https://api.example.com/users?a=1&cursor=" + tokenLast + "&sort=name",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var u url.URL
+			if tc.r != nil {
+				u = *tc.r.URL
+			}
+			out := tc.in.URLs(tc.r, tc.origin, "cursor")
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+			if tc.r != nil && *tc.r.URL != u {
+				t.Error("request URL modified")
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+)
+
+// NewNullable returns a Nullable pointer based on this value.
+func NewNullable[T Pointer](v T) Nullable[T] {
+	return Nullable[T]{Value: v}
+}
+
+// Null returns a Nullable pointer representing a NULL value.
+func Null[T Pointer]() Nullable[T] {
+	return Nullable[T]{Null: true}
+}
+
+// Nullable wraps a Pointer to manage nullable column, telling a NULL value apart from an absent one.
+// It is encoded as a JSON array, empty for a NULL value, such as [] or [1].
+//
+// Statement considers NULL values as the smallest ones, like MySQL and MariaDB do,
+// and adds the IS NULL or IS NOT NULL conditions required to paginate a nullable column.
+// It requires the names of the columns: without them, only a NULL pointer is compared with IS NULL or IS NOT NULL.
+type Nullable[T Pointer] struct {
+	Value T
+	Null  bool
+}
+
+// Args implements the Pointer interface.
+// A NULL value has no argument, unlike a zero value, such as 0, which is compared as such.
+func (n Nullable[T]) Args() []any {
+	if n.Null {
+		return nil
+	}
+	return []any{valueOf(n.Value)}
+}
+
+// value implements the valuer interface.
+func (n Nullable[T]) value() any {
+	if n.Null {
+		return nil
+	}
+	return valueOf(n.Value)
+}
+
+// Compare implements the Comparer interface.
+// NULL is less than the other values, as ordered by MySQL.
+func (n Nullable[T]) Compare(other Pointer) int {
+	o := mustBe[Nullable[T]](n, other)
+	if n.Null || o.Null {
+		return cmp.Compare(boolInt(!n.Null), boolInt(!o.Null))
+	}
+	return comparePointers(n.Value, o.Value)
+}
+
+// IsNull returns true if the value is NULL.
+func (n Nullable[T]) IsNull() bool {
+	return n.Null
+}
+
+// IsZero implements the Pointer interface.
+// A NULL value is not a zero value.
+func (n Nullable[T]) IsZero() bool {
+	return !n.Null && n.Value.IsZero()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.Null {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]any{n.Value})
+}
+
+// Placeholder implements the Placeholder interface.
+func (n Nullable[T]) Placeholder() string {
+	return placeholder(n.Value, 0)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var raw []json.RawMessage
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return fmt.Errorf("nullable: %w", err)
+	}
+	var v Nullable[T]
+	switch len(raw) {
+	case 0:
+		v.Null = true
+	case 1:
+		err = json.Unmarshal(raw[0], &v.Value)
+		if err != nil {
+			return fmt.Errorf("nullable: %w", err)
+		}
+	default:
+		return fmt.Errorf("nullable: got %d values, exp 1 at most", len(raw))
+	}
+	*n = v
+	return nil
+}
+
+// nullable is implemented by the pointers which can represent a NULL value.
+type nullable interface {
+	IsNull() bool
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/rvflash/cursor"
+)
+
+type nullableInt64 = cursor.Nullable[cursor.Int64]
+
+func TestNullable_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  nullableInt64
+		out []any
+	}{
+		"Default": {out: []any{cursor.Int64(0)}},
+		"Null":    {in: cursor.Null[cursor.Int64]()},
+		"Zero":    {in: cursor.NewNullable(cursor.Int64(0)), out: []any{cursor.Int64(0)}},
+		"OK":      {in: cursor.NewNullable(cursor.Int64(next)), out: []any{cursor.Int64(next)}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestNullable_IsZero(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  nullableInt64
+		out bool
+	}{
+		"Default": {out: true},
+		"Null":    {in: cursor.Null[cursor.Int64]()},
+		"OK":      {in: cursor.NewNullable(cursor.Int64(next))},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.IsZero()
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestNullable_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  nullableInt64
+		out string
+	}{
+		"Default": {out: `[0]`},
+		"Null":    {in: cursor.Null[cursor.Int64](), out: `[]`},
+		"OK":      {in: cursor.NewNullable(cursor.Int64(next)), out: `[3]`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := json.Marshal(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != tc.out {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestNullable_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out nullableInt64
+		msg string
+	}{
+		"Default": {msg: "unexpected end of JSON input"},
+		"Scalar":  {in: `3`, msg: "nullable: json: cannot unmarshal number"},
+		"Values":  {in: `[1,2]`, msg: "nullable: got 2 values, exp 1 at most"},
+		"Zero":    {in: `[0]`},
+		"Null":    {in: `[]`, out: cursor.Null[cursor.Int64]()},
+		"OK":      {in: `[3]`, out: cursor.NewNullable(cursor.Int64(next))},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out nullableInt64
+			err := json.Unmarshal([]byte(tc.in), &out)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestStatement_WhereCondition_Nullable(t *testing.T) {
+	t.Parallel()
+
+	type pointer = cursor.Tuple2[cursor.Nullable[cursor.Decimal], cursor.Int64]
+
+	var (
+		null  = cursor.NewTuple2(cursor.Null[cursor.Decimal](), cursor.Int64(next))
+		score = cursor.NewTuple2(cursor.NewNullable(cursor.Decimal("9.5")), cursor.Int64(next))
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		in cursor.Statement[pointer]
+		// outputs
+		query string
+		args  []any
+	}{
+		"Ascending - Next page - Null": {
+			in: cursor.Statement[pointer]{
+				Cursor: &cursor.Cursor[pointer]{Limit: limit, Next: &null},
+			},
+			query: " AND (score IS NOT NULL OR (score IS NULL AND id >= ?))",
+			args:  []any{cursor.Int64(next)},
+		},
+		"Ascending - Next page - Value": {
+			in: cursor.Statement[pointer]{
+				Cursor: &cursor.Cursor[pointer]{Limit: limit, Next: &score},
+			},
+			query: " AND (score > CAST(? AS DECIMAL(65,30)) OR (score = CAST(? AS DECIMAL(65,30)) AND id >= ?))",
+			args:  []any{"9.5", "9.5", cursor.Int64(next)},
+		},
+		"Ascending - Prev page - Null": {
+			in: cursor.Statement[pointer]{
+				Cursor: &cursor.Cursor[pointer]{Limit: limit, Prev: &null},
+			},
+			query: " AND score IS NULL AND id < ?",
+			args:  []any{cursor.Int64(next)},
+		},
+		"Ascending - Prev page - Value": {
+			in: cursor.Statement[pointer]{
+				Cursor: &cursor.Cursor[pointer]{Limit: limit, Prev: &score},
+			},
+			query: " AND ((score < CAST(? AS DECIMAL(65,30)) OR score IS NULL) OR (score = CAST(? AS DECIMAL(65,30)) AND id < ?))",
+			args:  []any{"9.5", "9.5", cursor.Int64(next)},
+		},
+		"Descending - Next page - Null": {
+			in: cursor.Statement[pointer]{
+				Cursor:          &cursor.Cursor[pointer]{Limit: limit, Next: &null},
+				DescendingOrder: true,
+			},
+			query: " AND score IS NULL AND id <= ?",
+			args:  []any{cursor.Int64(next)},
+		},
+		"Descending - Next page - Value": {
+			in: cursor.Statement[pointer]{
+				Cursor:          &cursor.Cursor[pointer]{Limit: limit, Next: &score},
+				DescendingOrder: true,
+			},
+			query: " AND ((score < CAST(? AS DECIMAL(65,30)) OR score IS NULL) OR (score = CAST(? AS DECIMAL(65,30)) AND id <= ?))",
+			args:  []any{"9.5", "9.5", cursor.Int64(next)},
+		},
+		"Descending - Prev page - Null": {
+			in: cursor.Statement[pointer]{
+				Cursor:          &cursor.Cursor[pointer]{Limit: limit, Prev: &null},
+				DescendingOrder: true,
+			},
+			query: " AND (score IS NOT NULL OR (score IS NULL AND id > ?))",
+			args:  []any{cursor.Int64(next)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			query, args := tc.in.WhereCondition("score", "id")
+			if query != tc.query {
+				t.Errorf("\ngot %s\nexp %s", query, tc.query)
+			}
+			if !reflect.DeepEqual(args, tc.args) {
+				t.Errorf("\ngot %#v\nexp %#v", args, tc.args)
+			}
+		})
+	}
+}
+
+func TestStatement_WhereCondition_Null(t *testing.T) {
+	t.Parallel()
+
+	null := cursor.Null[cursor.Int64]()
+	for name, tc := range map[string]struct {
+		// inputs
+		in cursor.Statement[nullableInt64]
+		// outputs
+		query string
+	}{
+		"Ascending - Next page": {
+			in: cursor.Statement[nullableInt64]{
+				Cursor: &cursor.Cursor[nullableInt64]{Limit: limit, Next: &null},
+			},
+		},
+		"Ascending - Prev page": {
+			in: cursor.Statement[nullableInt64]{
+				Cursor: &cursor.Cursor[nullableInt64]{Limit: limit, Prev: &null},
+			},
+			query: " IS NULL AND FALSE",
+		},
+		"Descending - Next page": {
+			in: cursor.Statement[nullableInt64]{
+				Cursor:          &cursor.Cursor[nullableInt64]{Limit: limit, Next: &null},
+				DescendingOrder: true,
+			},
+			query: " IS NULL",
+		},
+		"Descending - Prev page": {
+			in: cursor.Statement[nullableInt64]{
+				Cursor:          &cursor.Cursor[nullableInt64]{Limit: limit, Prev: &null},
+				DescendingOrder: true,
+			},
+			query: " IS NOT NULL",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			query, args := tc.in.WhereCondition()
+			if query != tc.query {
+				t.Errorf("\ngot %s\nexp %s", query, tc.query)
+			}
+			if len(args) != 0 {
+				t.Errorf("\ngot %#v\nexp no argument", args)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import "fmt"
+
+// NewPager returns the pager to navigate by page number from a cursor, such as: 1 … 6 7 [8] 9 10 … 42.
+// The first, previous, next and last pages are reached by keyset, using the cursor pointers,
+// the other ones by offset. Without total, the pager stops at the next page.
+// Tokens are signed with the secret, or only encoded without secret.
+func NewPager[T Pointer](c *Cursor[T], w Window, secret []byte) (*Pager, error) {
+	if c == nil || c.Limit == 0 {
+		return &Pager{}, nil
+	}
+	var (
+		cur   = c.CurrentPage()
+		last  = c.TotalPages()
+		start = max(1, cur-w.Width)
+		end   = cur + w.Width
+	)
+	switch {
+	case last > notFound:
+		end = min(max(last, cur), end)
+	case Next(c) != nil:
+		end = cur + 1
+	default:
+		end = cur
+	}
+	var (
+		p   = Pager{Current: cur}
+		err error
+	)
+	add := func(n int) {
+		if err != nil {
+			return
+		}
+		item := PagerItem{Number: n, Current: n == cur}
+		if !item.Current {
+			item.Token, err = pageToken(c, n, secret)
+		}
+		p.Items = append(p.Items, item)
+	}
+	if w.Ellipsis && start > 1 {
+		add(1)
+		if start > 3 {
+			p.Items = append(p.Items, PagerItem{Ellipsis: true})
+		} else if start == 3 {
+			add(2)
+		}
+	}
+	for n := start; n <= end; n++ {
+		add(n)
+	}
+	if w.Ellipsis && last > end {
+		if end < last-2 {
+			p.Items = append(p.Items, PagerItem{Ellipsis: true})
+		} else if end == last-2 {
+			add(last - 1)
+		}
+		add(last)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Window defines the page numbers to display in a Pager.
+type Window struct {
+	// Width is the number of pages to display on each side of the current page.
+	Width int
+	// Ellipsis adds the first and the last pages, with an ellipsis for the pages left out.
+	Ellipsis bool
+}
+
+// Pager contains the page numbers to display to navigate.
+type Pager struct {
+	Current int         `json:"current,omitempty"`
+	Items   []PagerItem `json:"items"`
+}
+
+// PagerItem is a page number or an ellipsis of a Pager.
+// The current page has no token.
+type PagerItem struct {
+	Number   int    `json:"number,omitempty"`
+	Token    string `json:"token,omitempty"`
+	Current  bool   `json:"current,omitempty"`
+	Ellipsis bool   `json:"ellipsis,omitempty"`
+}
+
+// pageToken returns the token of the page number n.
+func pageToken[T Pointer](c *Cursor[T], n int, secret []byte) (string, error) {
+	var p *Cursor[T]
+	switch cur := c.CurrentPage(); n {
+	case cur - 1:
+		p = Prev(c)
+	case cur + 1:
+		p = Next(c)
+	}
+	if p == nil && n == c.TotalPages() {
+		p = Last(c)
+	}
+	if p == nil && n == 1 {
+		// The first page is also known without the pointers.
+		p = &Cursor[T]{
+			Prev:    new(T),
+			Limit:   c.Limit,
+			Total:   c.Total,
+			Filters: c.Filters,
+		}
+	}
+	if p == nil {
+		p = &Cursor[T]{
+			Offset:  (n - 1) * c.Limit,
+			Limit:   c.Limit,
+			Total:   c.Total,
+			Filters: c.Filters,
+		}
+	}
+	if len(secret) == 0 {
+		return p.String(), nil
+	}
+	s, err := encryptString(p, secret)
+	if err != nil {
+		return "", fmt.Errorf("page %d: %w", n, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rvflash/cursor"
+)
+
+func TestNewPager(t *testing.T) {
+	t.Parallel()
+
+	var (
+		big   = 84
+		sum   = total * limit
+		first = cursor.Int64(5)
+		prv   = cursor.Int64(15)
+		nxt   = cursor.Int64(17)
+		page  = func(offset int, sum *int, prev, next *cursor.Int64) *cursor.Cursor[cursor.Int64] {
+			return &cursor.Cursor[cursor.Int64]{Prev: prev, Next: next, Offset: offset, Limit: limit, Total: sum}
+		}
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		in     *cursor.Cursor[cursor.Int64]
+		window cursor.Window
+		secret []byte
+		// outputs
+		out string
+	}{
+		"Default": {},
+		"Middle": {
+			in:     page(14, &big, &prv, &nxt),
+			window: cursor.Window{Width: 2, Ellipsis: true},
+			out:    "1(prev=0@0) … 6(@10) 7(prev=15@12) [8] 9(next=17@16) 10(@18) … 42(next=0@82)",
+		},
+		"Signed": {
+			in:     page(14, &big, &prv, &nxt),
+			window: cursor.Window{Width: 1},
+			secret: []byte(secret),
+			out:    "7(prev=15@12) [8] 9(next=17@16)",
+		},
+		"Start": {
+			in:     page(4, &sum, &first, &nxt),
+			window: cursor.Window{Width: 1, Ellipsis: true},
+			out:    "1(prev=0@0) 2(prev=5@2) [3] 4(next=17@6) … 10(next=0@18)",
+		},
+		"Gap of one page": {
+			in:     page(6, &sum, &first, &nxt),
+			window: cursor.Window{Width: 1, Ellipsis: true},
+			out:    "1(prev=0@0) 2(@2) 3(prev=5@4) [4] 5(next=17@8) … 10(next=0@18)",
+		},
+		"Last page": {
+			in:     page(18, &sum, &prv, new(cursor.Int64)),
+			window: cursor.Window{Width: 1, Ellipsis: true},
+			out:    "1(prev=0@0) … 9(prev=15@16) [10]",
+		},
+		"Unknown total": {
+			in:     page(4, nil, &first, &nxt),
+			window: cursor.Window{Width: 2, Ellipsis: true},
+			out:    "1(prev=0@0) 2(prev=5@2) [3] 4(next=17@6)",
+		},
+		"Unknown total without next": {
+			in:     page(4, nil, &first, nil),
+			window: cursor.Window{Width: 1},
+			out:    "2(prev=5@2) [3]",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := cursor.NewPager(tc.in, tc.window, tc.secret)
+			if err != nil {
+				checkErr(t, err, "")
+				return
+			}
+			items := make([]string, len(p.Items))
+			for k, v := range p.Items {
+				items[k] = pagerItem(t, v, tc.secret)
+			}
+			if out := strings.Join(items, " "); out != tc.out {
+				t.Errorf("\ngot %q\nexp %q", out, tc.out)
+			}
+		})
+	}
+}
+
+// pagerItem describes a pager item, such as 2(prev=5@2) for a page reached by keyset,
+// with the pointer and the offset of its cursor, or 2(@2) for a page reached by offset.
+func pagerItem(t *testing.T, v cursor.PagerItem, secret []byte) string {
+	t.Helper()
+
+	switch {
+	case v.Ellipsis:
+		return "…"
+	case v.Current:
+		return fmt.Sprintf("[%d]", v.Number)
+	}
+	var (
+		c   *cursor.Cursor[cursor.Int64]
+		err error
+	)
+	if len(secret) > 0 {
+		c, err = cursor.Decrypt[cursor.Int64]([]byte(v.Token), secret)
+	} else {
+		c = new(cursor.Cursor[cursor.Int64])
+		err = c.Decode([]byte(v.Token))
+	}
+	if err != nil {
+		t.Fatalf("page %d: %s", v.Number, err)
+	}
+	switch {
+	case c.Prev != nil:
+		return fmt.Sprintf("%d(prev=%d@%d)", v.Number, *c.Prev, c.Offset)
+	case c.Next != nil:
+		return fmt.Sprintf("%d(next=%d@%d)", v.Number, *c.Next, c.Offset)
+	default:
+		return fmt.Sprintf("%d(@%d)", v.Number, c.Offset)
+	}
+}
@@ -6,7 +6,7 @@ package cursor
 
 import "fmt"
 
-// Paginate generations all cursors to navigate from a cursor.
+// Paginate generations all cursors to navigate from a cursor, with its metadata.
 func Paginate[T Pointer](c *Cursor[T], secret []byte) (*Pagination, error) {
 	if len(secret) == 0 {
 		return &Pagination{
@@ -14,10 +14,12 @@ func Paginate[T Pointer](c *Cursor[T], secret []byte) (*Pagination, error) {
 			Prev:  Prev(c).String(),
 			Last:  Last(c).String(),
 			Next:  Next(c).String(),
+			Self:  Self(c).String(),
+			Meta:  NewMeta(c),
 		}, nil
 	}
 	var (
-		p   Pagination
+		p   = Pagination{Meta: NewMeta(c)}
 		err error
 	)
 	p.First, err = encryptString(First(c), secret)
@@ -36,15 +38,22 @@ func Paginate[T Pointer](c *Cursor[T], secret []byte) (*Pagination, error) {
 	if err != nil {
 		return nil, fmt.Errorf("last: %w", err)
 	}
+	p.Self, err = encryptString(Self(c), secret)
+	if err != nil {
+		return nil, fmt.Errorf("self: %w", err)
+	}
 	return &p, nil
 }
 
 // Pagination contains all cursors to navigate from a cursor.
+// Meta is only set by Paginate.
 type Pagination struct {
 	First string `json:"first,omitempty"`
 	Prev  string `json:"prev,omitempty"`
 	Last  string `json:"last,omitempty"`
 	Next  string `json:"next,omitempty"`
+	Self  string `json:"self,omitempty"`
+	Meta  *Meta  `json:"meta,omitempty"`
 }
 
 func encryptString[T Pointer](c *Cursor[T], secret []byte) (string, error) {
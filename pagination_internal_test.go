@@ -28,9 +28,10 @@ func TestPaginate(t *testing.T) {
 		total = 10
 	)
 	var (
-		prv = Int64(prev)
-		nxt = Int64(next)
-		sum = total
+		prv   = Int64(prev)
+		nxt   = Int64(next)
+		sum   = total
+		pages = total / limit
 	)
 	for name, tc := range map[string]struct {
 		// inputs
@@ -51,6 +52,7 @@ func TestPaginate(t *testing.T) {
 			out: &Pagination{
 				First: b64NoMore,
 				Prev:  b64NoMore,
+				Meta:  &Meta{CurrentPage: 2, Limit: limit},
 			},
 		},
 		"First Page": {
@@ -66,6 +68,8 @@ func TestPaginate(t *testing.T) {
 			out: &Pagination{
 				Next: "eyJuZXh0IjozLCJpc3N1ZWRfYXQiOjE3NjIxMDEzMzYsIk9mZnNldCI6MiwibGltaXQiOjIsInRvdGFsIjoxMCwiZmlsdGVycyI6eyJuZXciOlsidHJ1ZSJdfX0",
 				Last: "eyJuZXh0IjowLCJpc3N1ZWRfYXQiOjE3NjIxMDEzMzYsIk9mZnNldCI6OCwibGltaXQiOjIsInRvdGFsIjoxMCwiZmlsdGVycyI6eyJuZXciOlsidHJ1ZSJdfX0",
+				Self: "eyJwcmV2IjoxLCJuZXh0IjozLCJpc3N1ZWRfYXQiOjE3NjIxMDEzMzYsIk9mZnNldCI6MCwibGltaXQiOjIsInRvdGFsIjoxMCwiZmlsdGVycyI6eyJuZXciOlsidHJ1ZSJdfSwid2luZG93Ijp0cnVlfQ",
+				Meta: &Meta{CurrentPage: 1, TotalPages: &pages, TotalItems: &sum, Limit: limit, HasNext: true},
 			},
 		},
 		"OK": {
@@ -84,6 +88,8 @@ func TestPaginate(t *testing.T) {
 				Prev:  "eyJwcmV2IjowLCJpc3N1ZWRfYXQiOjE3NjIxMDEzMzYsIk9mZnNldCI6MCwibGltaXQiOjIsInRvdGFsIjoxMCwiZmlsdGVycyI6eyJuZXciOlsidHJ1ZSJdfX0",
 				Next:  "eyJuZXh0IjozLCJpc3N1ZWRfYXQiOjE3NjIxMDEzMzYsIk9mZnNldCI6NCwibGltaXQiOjIsInRvdGFsIjoxMCwiZmlsdGVycyI6eyJuZXciOlsidHJ1ZSJdfX0",
 				Last:  "eyJuZXh0IjowLCJpc3N1ZWRfYXQiOjE3NjIxMDEzMzYsIk9mZnNldCI6OCwibGltaXQiOjIsInRvdGFsIjoxMCwiZmlsdGVycyI6eyJuZXciOlsidHJ1ZSJdfX0",
+				Self:  "eyJwcmV2IjoxLCJuZXh0IjozLCJpc3N1ZWRfYXQiOjE3NjIxMDEzMzYsIk9mZnNldCI6MiwibGltaXQiOjIsInRvdGFsIjoxMCwiZmlsdGVycyI6eyJuZXciOlsidHJ1ZSJdfSwid2luZG93Ijp0cnVlfQ",
+				Meta:  &Meta{CurrentPage: 2, TotalPages: &pages, TotalItems: &sum, Limit: limit, HasNext: true},
 			},
 		},
 		"Signed": {
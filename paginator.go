@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"context"
+	"fmt"
+)
+
+// Source fetches the rows of a page.
+// Fetch returns up to Limit rows of the statement, in the order and from the offset defined by the statement,
+// starting from the pointers of its cursor. The extra row tells the Paginator if there are more rows.
+// See SQLSource and SliceSource.
+type Source[T Pointer, R any] interface {
+	Fetch(ctx context.Context, st Statement[T]) ([]R, error)
+}
+
+// SourceFunc is an adapter to use a function as Source, such as the client of a remote API.
+type SourceFunc[T Pointer, R any] func(ctx context.Context, st Statement[T]) ([]R, error)
+
+// Fetch implements the Source interface.
+func (f SourceFunc[T, R]) Fetch(ctx context.Context, st Statement[T]) ([]R, error) {
+	return f(ctx, st)
+}
+
+// Paginator owns the lifecycle of the cursor: it decodes and validates the token, fetches the rows of the page
+// with its Source, notifies them to the cursor and returns them in display order with the pagination.
+type Paginator[T Pointer, R any] struct {
+	// Source fetches the rows.
+	Source Source[T, R]
+	// Key returns the pointer of a row.
+	Key func(R) T
+	// Config defines the secret, the max age and the limits of the cursors. The HTTP settings are ignored.
+	Config Config
+	// DescendingOrder defines the result's order by default.
+	DescendingOrder bool
+}
+
+// Result is a page of rows with its pagination.
+type Result[R any] struct {
+	Rows       []R
+	Pagination *Pagination
+}
+
+// Paginate returns the page of the token, or the first page without token.
+// Malformed, tampered or expired tokens are reported with ErrMalformed, ErrSignatureMismatch or ErrExpired.
+func (p *Paginator[T, R]) Paginate(ctx context.Context, token string) (*Result[R], error) {
+	c, err := parseCursor[T](p.Config, token, p.Config.limit(""))
+	if err != nil {
+		return nil, err
+	}
+	return p.Fetch(ctx, c)
+}
+
+// Fetch returns the page of the cursor, such as the one retrieved with FromContext.
+// The cursor is updated with the rows of the page.
+func (p *Paginator[T, R]) Fetch(ctx context.Context, c *Cursor[T]) (*Result[R], error) {
+	if c == nil {
+		return nil, errMissingCursor
+	}
+	st := Statement[T]{Cursor: c, DescendingOrder: p.DescendingOrder}
+	rows, err := p.Source.Fetch(ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("fetch: %w", err)
+	}
+	keys := make([]T, len(rows))
+	for k, r := range rows {
+		keys[k] = p.Key(r)
+	}
+	rows = collect(st, rows, keys)
+	pg, err := Paginate(c, p.Config.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("paginate: %w", err)
+	}
+	return &Result[R]{Rows: rows, Pagination: pg}, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rvflash/cursor"
+)
+
+func TestPaginator_Paginate(t *testing.T) {
+	t.Parallel()
+
+	type link func(*cursor.Pagination) string
+	var (
+		first = func(p *cursor.Pagination) string { return p.First }
+		prv   = func(p *cursor.Pagination) string { return p.Prev }
+		nxt   = func(p *cursor.Pagination) string { return p.Next }
+		last  = func(p *cursor.Pagination) string { return p.Last }
+		cfg   = cursor.Config{Secret: []byte(secret), MaxAge: time.Hour, DefaultLimit: limit}
+		src   = cursor.SliceSource[cursor.Int64, int64]{
+			Rows:    ids,
+			Key:     func(v int64) cursor.Int64 { return cursor.Int64(v) },
+			Compare: cursor.Compare[cursor.Int64],
+		}
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		cfg   cursor.Config
+		desc  bool
+		links []link
+		// outputs
+		out  [][]int64
+		page int
+	}{
+		"Default":  {cfg: cfg, out: [][]int64{{1, 2}}, page: 1},
+		"Unsigned": {cfg: cursor.Config{DefaultLimit: limit}, links: []link{nxt}, out: [][]int64{{1, 2}, {3, 4}}, page: 2},
+		"Forward":  {cfg: cfg, links: []link{nxt, nxt}, out: [][]int64{{1, 2}, {3, 4}, {5}}, page: 3},
+		"Backward": {cfg: cfg, links: []link{nxt, nxt, prv, prv}, out: [][]int64{{1, 2}, {3, 4}, {5}, {3, 4}, {1, 2}}, page: 1},
+		"Last":     {cfg: cfg, links: []link{last}, out: [][]int64{{1, 2}, {4, 5}}, page: 1},
+		"First":    {cfg: cfg, links: []link{nxt, first}, out: [][]int64{{1, 2}, {3, 4}, {1, 2}}, page: 1},
+		"Descending": {
+			cfg:   cfg,
+			desc:  true,
+			links: []link{nxt, nxt, prv},
+			out:   [][]int64{{5, 4}, {3, 2}, {1}, {3, 2}},
+			page:  2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctx   = context.Background()
+				p     = cursor.Paginator[cursor.Int64, int64]{Source: src, Key: src.Key, Config: tc.cfg, DescendingOrder: tc.desc}
+				token string
+				out   [][]int64
+				res   *cursor.Result[int64]
+			)
+			for k := 0; k <= len(tc.links); k++ {
+				if k > 0 {
+					token = tc.links[k-1](res.Pagination)
+				}
+				var err error
+				res, err = p.Paginate(ctx, token)
+				if err != nil {
+					t.Fatal(err)
+				}
+				out = append(out, res.Rows)
+			}
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+			if res.Pagination.Meta == nil || res.Pagination.Meta.CurrentPage != tc.page {
+				t.Errorf("\ngot %#v\nexp page %d", res.Pagination.Meta, tc.page)
+			}
+		})
+	}
+}
+
+func TestPaginator_Errors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		oops = errors.New("oops")
+		cfg  = cursor.Config{Secret: []byte(secret), MaxAge: time.Hour, DefaultLimit: limit}
+		src  = cursor.SliceSource[cursor.Int64, int64]{
+			Rows:    ids,
+			Key:     func(v int64) cursor.Int64 { return cursor.Int64(v) },
+			Compare: cursor.Compare[cursor.Int64],
+		}
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		cfg   cursor.Config
+		src   cursor.Source[cursor.Int64, int64]
+		token string
+		// outputs
+		err error
+	}{
+		"Malformed": {cfg: cfg, src: src, token: "abc", err: cursor.ErrMalformed},
+		"Tampered":  {cfg: cfg, src: src, token: oldToken[:len(oldToken)-1] + "A", err: cursor.ErrSignatureMismatch},
+		"Expired":   {cfg: cfg, src: src, token: oldToken, err: cursor.ErrExpired},
+		"Failure": {
+			cfg: cfg,
+			src: cursor.SourceFunc[cursor.Int64, int64](func(context.Context, cursor.Statement[cursor.Int64]) ([]int64, error) {
+				return nil, oops
+			}),
+			err: oops,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p := cursor.Paginator[cursor.Int64, int64]{
+				Source: tc.src,
+				Key:    func(v int64) cursor.Int64 { return cursor.Int64(v) },
+				Config: tc.cfg,
+			}
+			res, err := p.Paginate(context.Background(), tc.token)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("\ngot %v\nexp %v", err, tc.err)
+			}
+			if res != nil {
+				t.Errorf("\ngot %#v\nexp nil", res)
+			}
+		})
+	}
+}
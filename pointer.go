@@ -4,6 +4,23 @@
 
 package cursor
 
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// decimalPlaceholder casts the bind parameter as a decimal, to be compared as such with a DECIMAL column.
+// Without it, MySQL compares strings and decimals as floating-point numbers.
+const decimalPlaceholder = "CAST(? AS DECIMAL(65,30))"
+
+var errDecimal = errors.New("invalid decimal format")
+
 // Pointer must be implemented by any cursor point.
 type Pointer interface {
 	// Args returns the arguments to use in a statement.
@@ -12,6 +29,139 @@ type Pointer interface {
 	IsZero() bool
 }
 
+// composite is implemented by the pointers composed of other pointers, such as List.
+type composite interface {
+	pointers() []Pointer
+}
+
+// Placeholder can be implemented by a Pointer to customize its bind parameter in a SQL statement,
+// a question mark by default.
+type Placeholder interface {
+	// Placeholder returns the expression to use as bind parameter.
+	Placeholder() string
+}
+
+// ParseDecimal parses a decimal number, such as -12.3400, and keeps it as is.
+func ParseDecimal(s string) (Decimal, error) {
+	if !isDecimal(s) {
+		return "", fmt.Errorf("decimal: %w: %q", errDecimal, s)
+	}
+	return Decimal(s), nil
+}
+
+// Decimal manages exact decimal pointer, such as a price stored in a DECIMAL column.
+// The value is kept as its exact string representation, also used in the cursor.
+type Decimal string
+
+// Args implements the Pointer interface.
+func (d Decimal) Args() []any {
+	if d.IsZero() {
+		return nil
+	}
+	return []any{string(d)}
+}
+
+// value implements the valuer interface.
+func (d Decimal) value() any {
+	if d.IsZero() {
+		return nil
+	}
+	return string(d)
+}
+
+// Compare implements the Comparer interface.
+// Decimals are compared by value, an empty decimal being less than the other ones.
+func (d Decimal) Compare(other Pointer) int {
+	o := mustBe[Decimal](d, other)
+	switch {
+	case d == o:
+		return 0
+	case d == "":
+		return -1
+	case o == "":
+		return 1
+	}
+	x, okX := new(big.Rat).SetString(string(d))
+	y, okY := new(big.Rat).SetString(string(o))
+	if !okX || !okY {
+		return strings.Compare(string(d), string(o))
+	}
+	return x.Cmp(y)
+}
+
+// IsZero implements the Pointer interface.
+func (d Decimal) IsZero() bool {
+	return d == ""
+}
+
+// Placeholder implements the Placeholder interface.
+// The parameter is cast as DECIMAL(65,30), so its integer part is limited to 35 digits.
+func (d Decimal) Placeholder() string {
+	return decimalPlaceholder
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// A JSON number is also accepted and kept as written.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if len(data) > 0 && data[0] == '"' {
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return fmt.Errorf("decimal: %w", err)
+		}
+	} else {
+		s = string(data)
+	}
+	if s == "" {
+		*d = ""
+		return nil
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Float64 manages float64 pointer, such as a score stored in a DOUBLE column.
+// It is encoded in the cursor with the shortest representation that round-trips.
+type Float64 float64
+
+// Args implements the Pointer interface.
+func (f Float64) Args() []any {
+	if f.IsZero() {
+		return nil
+	}
+	return []any{f}
+}
+
+// value implements the valuer interface.
+func (f Float64) value() any {
+	return f
+}
+
+// Compare implements the Comparer interface.
+func (f Float64) Compare(other Pointer) int {
+	return cmp.Compare(f, mustBe[Float64](f, other))
+}
+
+// IsZero implements the Pointer interface.
+func (f Float64) IsZero() bool {
+	return f == 0
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f Float64) MarshalJSON() ([]byte, error) {
+	if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+		return nil, fmt.Errorf("float64: unsupported value: %v", float64(f))
+	}
+	return strconv.AppendFloat(nil, float64(f), 'g', -1, 64), nil
+}
+
 // Int64 mangers int64 pointer.
 type Int64 int64
 
@@ -23,6 +173,16 @@ func (n Int64) Args() []any {
 	return []any{n}
 }
 
+// value implements the valuer interface.
+func (n Int64) value() any {
+	return n
+}
+
+// Compare implements the Comparer interface.
+func (n Int64) Compare(other Pointer) int {
+	return cmp.Compare(n, mustBe[Int64](n, other))
+}
+
 // IsZero implements the Pointer interface.
 func (n Int64) IsZero() bool {
 	return n == 0
@@ -33,6 +193,132 @@ type List []Pointer
 
 // Args implements the Pointer interface.
 func (l List) Args() []any {
+	return args(l)
+}
+
+// Compare implements the Comparer interface.
+// Lists are compared lexicographically, each value being compared with the one at the same position.
+func (l List) Compare(other Pointer) int {
+	return compareLists(l, mustBe[List](l, other))
+}
+
+// IsZero implements the Pointer interface.
+func (l List) IsZero() bool {
+	return isZero(l)
+}
+
+func (l List) pointers() []Pointer {
+	return l
+}
+
+// String manages string pointer.
+type String string
+
+// Args implements the Pointer interface.
+func (s String) Args() []any {
+	if s.IsZero() {
+		return nil
+	}
+	return []any{s}
+}
+
+// value implements the valuer interface.
+func (s String) value() any {
+	return s
+}
+
+// Compare implements the Comparer interface.
+// Strings are compared byte by byte, see CollatedString to use another collation.
+func (s String) Compare(other Pointer) int {
+	return strings.Compare(string(s), string(mustBe[String](s, other)))
+}
+
+// IsZero implements the Pointer interface.
+func (s String) IsZero() bool {
+	return s == ""
+}
+
+// Uint64 manages uint64 pointer, such as BIGINT UNSIGNED identifiers.
+// Its value is passed as is to the driver: the values from 2^63 require a driver accepting uint64 arguments
+// by implementing driver.NamedValueChecker, such as github.com/go-sql-driver/mysql,
+// database/sql rejecting them otherwise.
+type Uint64 uint64
+
+// Args implements the Pointer interface.
+func (n Uint64) Args() []any {
+	if n.IsZero() {
+		return nil
+	}
+	return []any{n}
+}
+
+// value implements the valuer interface.
+func (n Uint64) value() any {
+	return n
+}
+
+// Compare implements the Comparer interface.
+func (n Uint64) Compare(other Pointer) int {
+	return cmp.Compare(n, mustBe[Uint64](n, other))
+}
+
+// IsZero implements the Pointer interface.
+func (n Uint64) IsZero() bool {
+	return n == 0
+}
+
+// Uint64String manages uint64 pointer, encoded as a JSON string in the cursor
+// to survive clients that parse JSON numbers as float64 and lose precision beyond 2^53.
+// As with Uint64, the values from 2^63 require a driver accepting uint64 arguments.
+type Uint64String uint64
+
+// Args implements the Pointer interface.
+func (n Uint64String) Args() []any {
+	if n.IsZero() {
+		return nil
+	}
+	return []any{n}
+}
+
+// value implements the valuer interface.
+func (n Uint64String) value() any {
+	return n
+}
+
+// Compare implements the Comparer interface.
+func (n Uint64String) Compare(other Pointer) int {
+	return cmp.Compare(n, mustBe[Uint64String](n, other))
+}
+
+// IsZero implements the Pointer interface.
+func (n Uint64String) IsZero() bool {
+	return n == 0
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n Uint64String) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, strconv.FormatUint(uint64(n), 10)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// A JSON number is also accepted.
+func (n *Uint64String) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	s := string(data)
+	if len(s) > 1 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("uint64: %w", err)
+	}
+	*n = Uint64String(v)
+	return nil
+}
+
+func args(l []Pointer) []any {
 	n := len(l)
 	if n == 0 {
 		return nil
@@ -49,11 +335,33 @@ func (l List) Args() []any {
 	return a
 }
 
-// IsZero implements the Pointer interface.
-func (l List) IsZero() bool {
-	if len(l) == 0 {
-		return true
+// values returns the value of each pointer, including the zero ones.
+func values(l []Pointer) []any {
+	a := make([]any, len(l))
+	for k := range l {
+		a[k] = valueOf(l[k])
+	}
+	return a
+}
+
+// valuer is implemented by the built-in pointers to return their value, even the zero one,
+// unlike Args which has no argument for a zero pointer.
+type valuer interface {
+	value() any
+}
+
+// valueOf returns the value of the pointer to bind as argument, including its zero value.
+func valueOf(p Pointer) any {
+	if v, ok := p.(valuer); ok {
+		return v.value()
 	}
+	if a := p.Args(); len(a) == 1 {
+		return a[0]
+	}
+	return nil
+}
+
+func isZero(l []Pointer) bool {
 	for k := range l {
 		if !l[k].IsZero() {
 			return false
@@ -62,18 +370,20 @@ func (l List) IsZero() bool {
 	return true
 }
 
-// String manages string pointer.
-type String string
-
-// Args implements the Pointer interface.
-func (s String) Args() []any {
-	if s.IsZero() {
-		return nil
+func isDecimal(s string) bool {
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
 	}
-	return []any{s}
-}
-
-// IsZero implements the Pointer interface.
-func (s String) IsZero() bool {
-	return s == ""
+	var digits, dot int
+	for k := range s {
+		switch {
+		case s[k] >= '0' && s[k] <= '9':
+			digits++
+		case s[k] == '.' && dot == 0 && digits > 0 && k < len(s)-1:
+			dot++
+		default:
+			return false
+		}
+	}
+	return digits > 0
 }
@@ -5,6 +5,8 @@
 package cursor_test
 
 import (
+	"encoding/json"
+	"math"
 	"reflect"
 	"testing"
 
@@ -145,3 +147,337 @@ func TestString_IsZero(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDecimal(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out cursor.Decimal
+		msg string
+	}{
+		"Default":  {msg: "decimal: invalid decimal format"},
+		"Sign":     {in: "-", msg: "decimal: invalid decimal format"},
+		"Dot":      {in: "1.", msg: "decimal: invalid decimal format"},
+		"Dots":     {in: "1.2.3", msg: "decimal: invalid decimal format"},
+		"Exponent": {in: "1e3", msg: "decimal: invalid decimal format"},
+		"Integer":  {in: "42", out: "42"},
+		"Negative": {in: "-12.3400", out: "-12.3400"},
+		"Large":    {in: "12345678901234567890.123456789", out: "12345678901234567890.123456789"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := cursor.ParseDecimal(tc.in)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if out != tc.out {
+				t.Errorf("\ngot %q\nexp %q", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestDecimal_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Decimal
+		out []any
+	}{
+		"Default": {},
+		"Zero":    {in: "0.00", out: []any{"0.00"}},
+		"OK":      {in: "19.99", out: []any{"19.99"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestDecimal_IsZero(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Decimal
+		out bool
+	}{
+		"Default": {out: true},
+		"Zero":    {in: "0.00"},
+		"OK":      {in: "19.99"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.IsZero()
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out cursor.Decimal
+		msg string
+	}{
+		"Default": {msg: "unexpected end of JSON input"},
+		"Blank":   {in: `""`},
+		"Invalid": {in: `"19,99"`, msg: "decimal: invalid decimal format"},
+		"Number":  {in: `0.10000000000000000000000001`, out: "0.10000000000000000000000001"},
+		"OK":      {in: `"-12.3400"`, out: "-12.3400"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out cursor.Decimal
+			err := json.Unmarshal([]byte(tc.in), &out)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if out != tc.out {
+				t.Errorf("\ngot %q\nexp %q", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestFloat64_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Float64
+		out []any
+	}{
+		"Default":  {},
+		"Negative": {in: -0.5, out: []any{cursor.Float64(-0.5)}},
+		"Positive": {in: 0.1, out: []any{cursor.Float64(0.1)}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestFloat64_IsZero(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Float64
+		out bool
+	}{
+		"Default":  {out: true},
+		"Negative": {in: -0.5},
+		"Positive": {in: 0.1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.IsZero()
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestFloat64_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in cursor.Float64
+		// outputs
+		out string
+		msg string
+	}{
+		"Default":  {out: "0"},
+		"NaN":      {in: cursor.Float64(math.NaN()), msg: "float64: unsupported value: NaN"},
+		"Infinity": {in: cursor.Float64(math.Inf(1)), msg: "float64: unsupported value: +Inf"},
+		"Shortest": {in: 0.1, out: "0.1"},
+		"Precise":  {in: 0.30000000000000004, out: "0.30000000000000004"},
+		"Small":    {in: 5e-324, out: "5e-324"},
+		"Large":    {in: math.MaxFloat64, out: "1.7976931348623157e+308"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := json.Marshal(tc.in)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+				return
+			}
+			if string(out) != tc.out {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+			var back cursor.Float64
+			err = json.Unmarshal(out, &back)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if back != tc.in {
+				t.Errorf("\ngot %v\nexp %v", back, tc.in)
+			}
+		})
+	}
+}
+
+func TestUint64_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Uint64
+		out []any
+	}{
+		"Default": {},
+		"Max":     {in: math.MaxUint64, out: []any{cursor.Uint64(math.MaxUint64)}},
+		"OK":      {in: 1, out: []any{cursor.Uint64(1)}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestUint64_IsZero(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Uint64
+		out bool
+	}{
+		"Default": {out: true},
+		"OK":      {in: 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.IsZero()
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestUint64String_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Uint64String
+		out []any
+	}{
+		"Default": {},
+		"Max":     {in: math.MaxUint64, out: []any{cursor.Uint64String(math.MaxUint64)}},
+		"OK":      {in: 1, out: []any{cursor.Uint64String(1)}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestUint64String_IsZero(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Uint64String
+		out bool
+	}{
+		"Default": {out: true},
+		"OK":      {in: 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.IsZero()
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestUint64String_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Uint64String
+		out string
+	}{
+		"Default": {out: `"0"`},
+		"Max":     {in: math.MaxUint64, out: `"18446744073709551615"`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := json.Marshal(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != tc.out {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestUint64String_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out cursor.Uint64String
+		msg string
+	}{
+		"Default":  {msg: "unexpected end of JSON input"},
+		"Negative": {in: `"-1"`, msg: "uint64: strconv.ParseUint"},
+		"Overflow": {in: `"18446744073709551616"`, msg: "value out of range"},
+		"Number":   {in: `9007199254740993`, out: 9007199254740993},
+		"Max":      {in: `"18446744073709551615"`, out: math.MaxUint64},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out cursor.Uint64String
+			err := json.Unmarshal([]byte(tc.in), &out)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if out != tc.out {
+				t.Errorf("\ngot %d\nexp %d", out, tc.out)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	errMissingLimit      = errors.New("missing limit")
+	errMissingColumns    = errors.New("missing columns")
+	errMismatchedColumns = errors.New("mismatched columns")
+)
+
+// Querier is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Select contains the parts of the SELECT statement to paginate.
+type Select struct {
+	// Query is the statement without WHERE, ORDER BY and LIMIT clauses, such as: SELECT id, name FROM users.
+	Query string
+	// Where is the optional condition to filter the rows, such as: deleted_at IS NULL.
+	Where string
+	// Args are the arguments of the query and its condition.
+	Args []any
+	// Columns are the columns of the pointer, optional if it implements the Sorter interface.
+	// In this case, they must match the columns of the pointer, their sort order being the one of the pointer.
+	Columns []string
+}
+
+// Query runs the SELECT statement with the cursor's conditions, and returns the rows of the page in display order.
+// The scan function returns each row with its pointer.
+// The cursor of the statement is updated with these rows and returned, ready to be used by Paginate.
+func Query[T Pointer, R any](
+	ctx context.Context, db Querier, st Statement[T], sel Select, scan func(*sql.Rows) (R, T, error),
+) ([]R, *Cursor[T], error) {
+	query, args, err := st.query(sel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query: %w", err)
+	}
+	var keys []T
+	rows, err := queryRows(ctx, db, query, args, func(rs *sql.Rows) (R, error) {
+		r, k, err := scan(rs)
+		keys = append(keys, k)
+		return r, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return collect(st, rows, keys), st.Cursor, nil
+}
+
+// SQLSource is a Source running the SELECT statement with the cursor's conditions.
+type SQLSource[T Pointer, R any] struct {
+	// DB runs the query.
+	DB Querier
+	// Select is the statement to paginate.
+	Select Select
+	// Scan returns the row.
+	Scan func(*sql.Rows) (R, error)
+}
+
+// Fetch implements the Source interface.
+func (s SQLSource[T, R]) Fetch(ctx context.Context, st Statement[T]) ([]R, error) {
+	query, args, err := st.query(s.Select)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	return queryRows(ctx, s.DB, query, args, s.Scan)
+}
+
+// queryRows runs the query and scans its rows.
+func queryRows[R any](
+	ctx context.Context, db Querier, query string, args []any, scan func(*sql.Rows) (R, error),
+) ([]R, error) {
+	rs, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer func() { _ = rs.Close() }()
+
+	var rows []R
+	for rs.Next() {
+		r, err := scan(rs)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		rows = append(rows, r)
+	}
+	err = rs.Err()
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	return rows, nil
+}
+
+// query returns the SELECT statement with its arguments.
+func (s Statement[T]) query(sel Select) (string, []any, error) {
+	if s.Cursor == nil || s.Cursor.Limit == 0 {
+		return "", nil, errMissingLimit
+	}
+	if len(sel.Columns) == 0 && len(s.sortColumns(nil)) == 0 {
+		return "", nil, errMissingColumns
+	}
+	if _, err := s.sorterColumns(sel.Columns); err != nil {
+		return "", nil, err
+	}
+	var (
+		buf   = new(strings.Builder)
+		args  = slices.Clone(sel.Args)
+		conds []string
+	)
+	_, _ = fmt.Fprint(buf, sel.Query)
+	if sel.Where != "" {
+		conds = append(conds, "("+sel.Where+")")
+	}
+	if cond, a := s.WhereCondition(sel.Columns...); cond != "" {
+		conds = append(conds, strings.TrimPrefix(cond, " AND "))
+		args = append(args, a...)
+	}
+	if len(conds) > 0 {
+		_, _ = fmt.Fprintf(buf, " WHERE %s", strings.Join(conds, " AND "))
+	}
+	_, _ = fmt.Fprintf(buf, " ORDER BY%s LIMIT %s", s.OrderBy(sel.Columns...), mysqlQueryArg)
+	args = append(args, s.Limit())
+	if n := s.Offset(); n > 0 {
+		_, _ = fmt.Fprintf(buf, " OFFSET %s", mysqlQueryArg)
+		args = append(args, n)
+	}
+	return buf.String(), args, nil
+}
+
+// collect updates the cursor of the statement with the fetched rows and their pointers,
+// and returns the rows of the page in display order.
+// The rows of the previous and the last pages are fetched in the reverse order, the extra row, if any,
+// tells if there are more rows before. The rows of the other pages are notified to the cursor as usual.
+func collect[T Pointer, R any](st Statement[T], rows []R, keys []T) []R {
+	c := st.Cursor
+	if !st.reversed() {
+		c.Reset()
+		for _, k := range keys {
+			c.Add(k)
+		}
+		return rows[:min(len(rows), c.Limit)]
+	}
+	var (
+		anchor = c.Prev
+		last   = c.Next != nil
+	)
+	if st.skipBackward() && len(rows) > 0 {
+		// The first row, skipped backward from the anchor, starts the next page.
+		k := keys[0]
+		anchor, rows, keys = &k, rows[1:], keys[1:]
+	}
+	var (
+		n    = min(len(rows), c.size())
+		more = len(rows) > c.size()
+	)
+	if last && c.Total != nil {
+		// The last page can be partial.
+		if m := *c.Total - c.Offset; m > 0 && m < n {
+			n = m
+		}
+	}
+	rows, keys = rows[:n], keys[:n]
+	slices.Reverse(rows)
+	slices.Reverse(keys)
+
+	c.Reset()
+	for _, k := range keys {
+		c.Add(k)
+	}
+	if !last {
+		// The anchor starts the next page.
+		c.Next = anchor
+		if !more {
+			c.Offset = 0
+		}
+	}
+	return rows
+}
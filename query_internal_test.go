@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStatement_query(t *testing.T) {
+	t.Parallel()
+
+	const limit = 2
+	var (
+		prv = Int64(1)
+		nxt = Int64(3)
+		sel = Select{
+			Query:   "SELECT id, name FROM users",
+			Where:   "deleted_at IS NULL OR status = ?",
+			Args:    []any{"active"},
+			Columns: []string{"id"},
+		}
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		in  Statement[Int64]
+		sel Select
+		// outputs
+		query string
+		args  []any
+		err   error
+	}{
+		"Default":         {err: errMissingLimit},
+		"Missing columns": {in: Statement[Int64]{Cursor: &Cursor[Int64]{Limit: limit}}, err: errMissingColumns},
+		"First page": {
+			in:    Statement[Int64]{Cursor: &Cursor[Int64]{Limit: limit}},
+			sel:   Select{Query: "SELECT id FROM users", Columns: []string{"id"}},
+			query: "SELECT id FROM users ORDER BY id ASC LIMIT ?",
+			args:  []any{limit + 1},
+		},
+		"Next page": {
+			in:    Statement[Int64]{Cursor: &Cursor[Int64]{Next: &nxt, Limit: limit}, DescendingOrder: true},
+			sel:   sel,
+			query: "SELECT id, name FROM users WHERE (deleted_at IS NULL OR status = ?) AND id <= ? ORDER BY id DESC LIMIT ?",
+			args:  []any{"active", nxt, limit + 1},
+		},
+		"Prev page": {
+			in:    Statement[Int64]{Cursor: &Cursor[Int64]{Prev: &prv, Offset: limit, Limit: limit}},
+			sel:   sel,
+			query: "SELECT id, name FROM users WHERE (deleted_at IS NULL OR status = ?) AND id < ? ORDER BY id DESC LIMIT ?",
+			args:  []any{"active", prv, limit + 1},
+		},
+		"Offset": {
+			in:    Statement[Int64]{Cursor: &Cursor[Int64]{Offset: limit * 2, Limit: limit}},
+			sel:   sel,
+			query: "SELECT id, name FROM users WHERE (deleted_at IS NULL OR status = ?) ORDER BY id ASC LIMIT ? OFFSET ?",
+			args:  []any{"active", limit + 1, limit * 2},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			query, args, err := tc.in.query(tc.sel)
+			if err != tc.err {
+				t.Errorf("\ngot %v\nexp %v", err, tc.err)
+			}
+			if query != tc.query {
+				t.Errorf("\ngot %s\nexp %s", query, tc.query)
+			}
+			if !reflect.DeepEqual(args, tc.args) {
+				t.Errorf("\ngot %#v\nexp %#v", args, tc.args)
+			}
+		})
+	}
+}
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	const limit = 2
+	var (
+		zero  = Int64(0)
+		one   = Int64(1)
+		two   = Int64(2)
+		three = Int64(3)
+		four  = Int64(4)
+		five  = Int64(5)
+		sum   = 5
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		in   *Cursor[Int64]
+		rows []int64
+		// outputs
+		out    []int64
+		cursor *Cursor[Int64]
+	}{
+		"First page": {
+			in:     &Cursor[Int64]{Prev: &zero, Limit: limit, Total: &sum},
+			rows:   []int64{1, 2, 3},
+			out:    []int64{1, 2},
+			cursor: &Cursor[Int64]{Prev: &one, Next: &three, Limit: limit, Total: &sum},
+		},
+		"Next page": {
+			in:     &Cursor[Int64]{Next: &three, Offset: limit, Limit: limit, Total: &sum},
+			rows:   []int64{3, 4},
+			out:    []int64{3, 4},
+			cursor: &Cursor[Int64]{Prev: &three, Offset: limit, Limit: limit, Total: &sum},
+		},
+		"Prev page": {
+			in:     &Cursor[Int64]{Prev: &five, Offset: limit, Limit: limit, Total: &sum},
+			rows:   []int64{4, 3, 2},
+			out:    []int64{3, 4},
+			cursor: &Cursor[Int64]{Prev: &three, Next: &five, Offset: limit, Limit: limit, Total: &sum},
+		},
+		"Prev page is the first one": {
+			in:     &Cursor[Int64]{Prev: &four, Offset: limit * 2, Limit: limit, Total: &sum},
+			rows:   []int64{3, 2},
+			out:    []int64{2, 3},
+			cursor: &Cursor[Int64]{Prev: &two, Next: &four, Limit: limit, Total: &sum},
+		},
+		"Last page": {
+			in:     &Cursor[Int64]{Next: &zero, Offset: limit * 2, Limit: limit, Total: &sum},
+			rows:   []int64{5, 4},
+			out:    []int64{5},
+			cursor: &Cursor[Int64]{Prev: &five, Offset: limit * 2, Limit: limit, Total: &sum},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			keys := make([]Int64, len(tc.rows))
+			for k, v := range tc.rows {
+				keys[k] = Int64(v)
+			}
+			out := collect(Statement[Int64]{Cursor: tc.in}, tc.rows, keys)
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+			tc.in.cnt, tc.in.last = 0, nil
+			if !reflect.DeepEqual(tc.in, tc.cursor) {
+				t.Errorf("\ngot %#v\nexp %#v", tc.in, tc.cursor)
+			}
+		})
+	}
+}
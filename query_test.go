@@ -0,0 +1,337 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/rvflash/cursor"
+	"github.com/rvflash/cursor/cursortest"
+	"github.com/rvflash/cursor/internal/memsql"
+)
+
+// users are the rows of the users table, with duplicate creation dates.
+var users = func() []user {
+	day := time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC)
+	return []user{
+		{CreatedAt: day, ID: 1, Name: "Alice"},
+		{CreatedAt: day.Add(time.Hour), ID: 2, Name: "Bob"},
+		{CreatedAt: day, ID: 3, Name: "Carol"},
+		{CreatedAt: day.Add(2 * time.Hour), ID: 4, Name: "Dave"},
+		{CreatedAt: day.Add(time.Hour), ID: 5, Name: "Eve"},
+		{CreatedAt: day, ID: 6, Name: "Frank"},
+		{CreatedAt: day.Add(3 * time.Hour), ID: 7, Name: "Grace"},
+	}
+}()
+
+// openUsers returns an in-memory database with the users table.
+func openUsers(t *testing.T) *sql.DB {
+	t.Helper()
+
+	table := memsql.Table{Name: "users", Columns: []string{"id", "name", "created_at"}}
+	for _, u := range users {
+		table.Rows = append(table.Rows, []any{u.ID, u.Name, u.CreatedAt})
+	}
+	db, err := memsql.Open(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	const seed = 42
+	var (
+		db   = openUsers(t)
+		rows = users
+	)
+	// Sorted by created_at DESC, then id ASC.
+	byKey := slices.Clone(rows)
+	slices.SortFunc(byKey, func(a, b user) int { return cursor.Compare(cursor.KeyOf(a), cursor.KeyOf(b)) })
+	byID := make([]int64, len(rows))
+	for k, u := range rows {
+		byID[k] = u.ID
+	}
+	for name, desc := range map[string]bool{"Ascending": false, "Descending": true} {
+		t.Run(name+" ID", func(t *testing.T) {
+			t.Parallel()
+
+			exp := slices.Clone(byID)
+			if desc {
+				slices.Reverse(exp)
+			}
+			cursortest.Run(t, cursortest.Config[cursor.Int64, int64]{
+				Rows:            exp,
+				DescendingOrder: desc,
+				Seed:            seed,
+				Fetch: func(ctx context.Context, st cursor.Statement[cursor.Int64]) ([]int64, error) {
+					res, _, err := cursor.Query(ctx, db, st, cursor.Select{
+						Query:   "SELECT id FROM users",
+						Columns: []string{"id"},
+					}, func(rs *sql.Rows) (id int64, k cursor.Int64, err error) {
+						err = rs.Scan(&id)
+						return id, cursor.Int64(id), err
+					})
+					return res, err
+				},
+			})
+		})
+		t.Run(name+" key", func(t *testing.T) {
+			t.Parallel()
+
+			exp := slices.Clone(byKey)
+			if desc {
+				slices.Reverse(exp)
+			}
+			cursortest.Run(t, cursortest.Config[cursor.Key[user], user]{
+				Rows:            exp,
+				DescendingOrder: desc,
+				Seed:            seed,
+				Fetch: func(ctx context.Context, st cursor.Statement[cursor.Key[user]]) ([]user, error) {
+					res, _, err := cursor.Query(ctx, db, st, cursor.Select{
+						Query: "SELECT id, name, created_at FROM users",
+						Where: "name <> ?",
+						Args:  []any{"Nobody"},
+					}, func(rs *sql.Rows) (u user, k cursor.Key[user], err error) {
+						err = rs.Scan(&u.ID, &u.Name, &u.CreatedAt)
+						return u, cursor.KeyOf(u), err
+					})
+					return res, err
+				},
+			})
+		})
+	}
+}
+
+func TestQuery_MismatchedColumns(t *testing.T) {
+	t.Parallel()
+
+	for name, cols := range map[string][]string{
+		"Count": {"id"},
+		"Name":  {"created_at", "name"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			st := cursor.Statement[cursor.Key[user]]{Cursor: cursor.New[cursor.Key[user]](limit, 0)}
+			_, _, err := cursor.Query(context.Background(), openUsers(t), st, cursor.Select{
+				Query:   "SELECT id, name, created_at FROM users",
+				Columns: cols,
+			}, func(rs *sql.Rows) (u user, k cursor.Key[user], err error) {
+				err = rs.Scan(&u.ID, &u.Name, &u.CreatedAt)
+				return u, cursor.KeyOf(u), err
+			})
+			checkErr(t, err, "query: mismatched columns")
+		})
+	}
+}
+
+func TestQuery_Uint64(t *testing.T) {
+	t.Parallel()
+
+	const seed = 42
+	var (
+		ids   = []uint64{1, math.MaxInt64, math.MaxInt64 + 1, math.MaxInt64 + 2, math.MaxUint64 - 1, math.MaxUint64}
+		table = memsql.Table{Name: "accounts", Columns: []string{"id"}}
+	)
+	for _, id := range ids {
+		table.Rows = append(table.Rows, []any{id})
+	}
+	db, err := memsql.Open(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	cursortest.Run(t, cursortest.Config[cursor.Uint64, uint64]{
+		Rows: ids,
+		Seed: seed,
+		Fetch: func(ctx context.Context, st cursor.Statement[cursor.Uint64]) ([]uint64, error) {
+			res, _, err := cursor.Query(ctx, db, st, cursor.Select{
+				Query:   "SELECT id FROM accounts",
+				Columns: []string{"id"},
+			}, func(rs *sql.Rows) (id uint64, k cursor.Uint64, err error) {
+				err = rs.Scan(&id)
+				return id, cursor.Uint64(id), err
+			})
+			return res, err
+		},
+	})
+}
+
+func TestQuery_Tuple(t *testing.T) {
+	t.Parallel()
+
+	type (
+		pointer = cursor.Tuple2[cursor.Time, cursor.Int64]
+		scored  = cursor.Tuple2[cursor.Float64, cursor.Int64]
+		player  struct {
+			ID    int64
+			Score float64
+		}
+	)
+	const seed = 42
+	var (
+		db  = openUsers(t)
+		key = func(u user) pointer {
+			return cursor.NewTuple2(cursor.NewTime(u.CreatedAt, cursor.Second), cursor.Int64(u.ID))
+		}
+		// The zero scores are ordinary values, at the boundary of the pages.
+		players = []player{{1, 0}, {2, 2.5}, {3, 0}, {4, -1}, {5, 0}, {6, 2.5}, {7, 0}}
+		table   = memsql.Table{Name: "players", Columns: []string{"id", "score"}}
+		score   = func(p player) scored {
+			return cursor.NewTuple2(cursor.Float64(p.Score), cursor.Int64(p.ID))
+		}
+	)
+	for _, p := range players {
+		table.Rows = append(table.Rows, []any{p.ID, p.Score})
+	}
+	scores, err := memsql.Open(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = scores.Close() })
+
+	// Sorted by created_at, then id.
+	rows := slices.Clone(users)
+	slices.SortFunc(rows, func(a, b user) int { return cursor.Compare(key(a), key(b)) })
+	// Sorted by score, then id.
+	ranking := slices.Clone(players)
+	slices.SortFunc(ranking, func(a, b player) int { return cursor.Compare(score(a), score(b)) })
+
+	for name, desc := range map[string]bool{"Ascending": false, "Descending": true} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			exp := slices.Clone(rows)
+			if desc {
+				slices.Reverse(exp)
+			}
+			cursortest.Run(t, cursortest.Config[pointer, user]{
+				Rows:            exp,
+				DescendingOrder: desc,
+				Seed:            seed,
+				Fetch: func(ctx context.Context, st cursor.Statement[pointer]) ([]user, error) {
+					res, _, err := cursor.Query(ctx, db, st, cursor.Select{
+						Query:   "SELECT id, name, created_at FROM users",
+						Columns: []string{"created_at", "id"},
+					}, func(rs *sql.Rows) (u user, k pointer, err error) {
+						err = rs.Scan(&u.ID, &u.Name, &u.CreatedAt)
+						return u, key(u), err
+					})
+					return res, err
+				},
+			})
+		})
+		t.Run(name+" with zero values", func(t *testing.T) {
+			t.Parallel()
+
+			exp := slices.Clone(ranking)
+			if desc {
+				slices.Reverse(exp)
+			}
+			cursortest.Run(t, cursortest.Config[scored, player]{
+				Rows:            exp,
+				DescendingOrder: desc,
+				Seed:            seed,
+				Fetch: func(ctx context.Context, st cursor.Statement[scored]) ([]player, error) {
+					res, _, err := cursor.Query(ctx, scores, st, cursor.Select{
+						Query:   "SELECT id, score FROM players",
+						Columns: []string{"score", "id"},
+					}, func(rs *sql.Rows) (p player, k scored, err error) {
+						err = rs.Scan(&p.ID, &p.Score)
+						return p, score(p), err
+					})
+					return res, err
+				},
+			})
+		})
+	}
+}
+
+func TestQuery_Nullable(t *testing.T) {
+	t.Parallel()
+
+	type (
+		pointer = cursor.Tuple2[cursor.Nullable[cursor.Int64], cursor.Int64]
+		player  struct {
+			ID    int64
+			Score sql.NullInt64
+		}
+	)
+	const seed = 42
+	var (
+		players = []player{
+			{ID: 1, Score: sql.NullInt64{Int64: 10, Valid: true}},
+			{ID: 2},
+			{ID: 3, Score: sql.NullInt64{Int64: 5, Valid: true}},
+			{ID: 4},
+			{ID: 5, Score: sql.NullInt64{Int64: 10, Valid: true}},
+			{ID: 6},
+			{ID: 7, Score: sql.NullInt64{Int64: 5, Valid: true}},
+			{ID: 8, Score: sql.NullInt64{Int64: 20, Valid: true}},
+			{ID: 9, Score: sql.NullInt64{Valid: true}},
+			{ID: 10, Score: sql.NullInt64{Valid: true}},
+		}
+		table = memsql.Table{Name: "players", Columns: []string{"id", "score"}}
+		key   = func(p player) pointer {
+			score := cursor.Null[cursor.Int64]()
+			if p.Score.Valid {
+				score = cursor.NewNullable(cursor.Int64(p.Score.Int64))
+			}
+			return cursor.NewTuple2(score, cursor.Int64(p.ID))
+		}
+	)
+	for _, p := range players {
+		var score any
+		if p.Score.Valid {
+			score = p.Score.Int64
+		}
+		table.Rows = append(table.Rows, []any{p.ID, score})
+	}
+	db, err := memsql.Open(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	// Sorted by score, NULL first, then id.
+	rows := slices.Clone(players)
+	slices.SortFunc(rows, func(a, b player) int { return cursor.Compare(key(a), key(b)) })
+
+	for name, desc := range map[string]bool{"Ascending": false, "Descending": true} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			exp := slices.Clone(rows)
+			if desc {
+				slices.Reverse(exp)
+			}
+			cursortest.Run(t, cursortest.Config[pointer, player]{
+				Rows:            exp,
+				DescendingOrder: desc,
+				Seed:            seed,
+				Fetch: func(ctx context.Context, st cursor.Statement[pointer]) ([]player, error) {
+					res, _, err := cursor.Query(ctx, db, st, cursor.Select{
+						Query:   "SELECT id, score FROM players",
+						Columns: []string{"score", "id"},
+					}, func(rs *sql.Rows) (p player, k pointer, err error) {
+						err = rs.Scan(&p.ID, &p.Score)
+						return p, key(p), err
+					})
+					return res, err
+				},
+			})
+		})
+	}
+}
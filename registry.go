@@ -0,0 +1,162 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var registry = struct {
+	sync.RWMutex
+	names map[string]*registration
+	types map[reflect.Type]*registration
+}{
+	names: make(map[string]*registration),
+	types: make(map[reflect.Type]*registration),
+}
+
+func init() {
+	Register[Int64]("int64")
+	Register[String]("string")
+	Register[Uint64]("uint64")
+	Register[Uint64String]("uint64s")
+	Register[Float64]("float64")
+	Register[Decimal]("decimal")
+	Register[Time]("time")
+	Register[UUID]("uuid")
+	Register[BinaryUUID]("buuid")
+	Register[List]("list")
+}
+
+// Register makes a Pointer type available under this name, used as type tag to encode and decode a List.
+// If Register is called twice with the same name or type, it panics.
+// The built-in pointers are registered by default.
+func Register[T Pointer](name string) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	typ := reflect.TypeFor[T]()
+	if name == "" {
+		panic("cursor: Register name is empty")
+	}
+	if _, dup := registry.names[name]; dup {
+		panic("cursor: Register called twice for name " + name)
+	}
+	if _, dup := registry.types[typ]; dup {
+		panic("cursor: Register called twice for type " + typ.String())
+	}
+	r := &registration{
+		name: name,
+		decode: func(data []byte) (Pointer, error) {
+			var v T
+			err := json.Unmarshal(data, &v)
+			return v, err
+		},
+	}
+	registry.names[name] = r
+	registry.types[typ] = r
+}
+
+type registration struct {
+	name   string
+	decode func(data []byte) (Pointer, error)
+}
+
+func lookupName(name string) (*registration, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	r, ok := registry.names[name]
+	return r, ok
+}
+
+func lookupType(p Pointer) (*registration, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	r, ok := registry.types[reflect.TypeOf(p)]
+	return r, ok
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Each value is encoded with the type tag of its registered Pointer type, such as [["int64",1],["string","a"]].
+// The values of an unregistered type are encoded without type tag, to be decoded as Int64 or String.
+func (l List) MarshalJSON() ([]byte, error) {
+	a := make([]any, len(l))
+	for k := range l {
+		if r, ok := lookupType(l[k]); ok {
+			a[k] = [2]any{r.name, l[k]}
+		} else {
+			a[k] = l[k]
+		}
+	}
+	return json.Marshal(a)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It uses the type tags to decode each value with its registered Pointer type.
+// Values without type tag are decoded as Int64 for integers and String for strings.
+func (l *List) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var raw []json.RawMessage
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return fmt.Errorf("list: %w", err)
+	}
+	v := make(List, len(raw))
+	for k := range raw {
+		v[k], err = decodeListValue(raw[k])
+		if err != nil {
+			return fmt.Errorf("list: value %d: %w", k+1, err)
+		}
+	}
+	*l = v
+	return nil
+}
+
+func decodeListValue(data []byte) (Pointer, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '[' {
+		return decodeUntagged(data)
+	}
+	var (
+		raw  []json.RawMessage
+		name string
+	)
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != 2 {
+		return nil, fmt.Errorf("got %d elements, exp a type tag and a value", len(raw))
+	}
+	err = json.Unmarshal(raw[0], &name)
+	if err != nil {
+		return nil, fmt.Errorf("type tag: %w", err)
+	}
+	r, ok := lookupName(name)
+	if !ok {
+		return nil, fmt.Errorf("unregistered type tag %q", name)
+	}
+	return r.decode(raw[1])
+}
+
+// decodeUntagged decodes the values encoded before the introduction of type tags.
+func decodeUntagged(data []byte) (Pointer, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var s String
+		err := json.Unmarshal(data, &s)
+		return s, err
+	}
+	var n Int64
+	err := json.Unmarshal(data, &n)
+	return n, err
+}
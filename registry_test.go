@@ -0,0 +1,154 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/rvflash/cursor"
+)
+
+// country is a custom pointer type.
+type country string
+
+func (c country) Args() []any  { return []any{string(c)} }
+func (c country) IsZero() bool { return c == "" }
+
+func init() {
+	cursor.Register[country]("country")
+}
+
+// region is an unregistered custom pointer type.
+type region string
+
+func (r region) Args() []any  { return []any{string(r)} }
+func (r region) IsZero() bool { return r == "" }
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		fn  func()
+		msg string
+	}{
+		"Blank":     {fn: func() { cursor.Register[cursor.Int64]("") }, msg: "cursor: Register name is empty"},
+		"Same name": {fn: func() { cursor.Register[cursor.Int64]("country") }, msg: "cursor: Register called twice for name country"},
+		"Same type": {fn: func() { cursor.Register[country]("nation") }, msg: "cursor: Register called twice for type cursor_test.country"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if r := recover(); r != tc.msg {
+					t.Errorf("\ngot %v\nexp %s", r, tc.msg)
+				}
+			}()
+			tc.fn()
+		})
+	}
+}
+
+func TestList_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in cursor.List
+		// outputs
+		out string
+		msg string
+	}{
+		"Default": {out: `[]`},
+		"Unregistered": {
+			in:  cursor.List{region("idf"), cursor.Int64(next)},
+			out: `["idf",["int64",3]]`,
+		},
+		"OK": {
+			in:  cursor.List{country("fr"), cursor.Decimal("19.99"), cursor.Int64(next)},
+			out: `[["country","fr"],["decimal","19.99"],["int64",3]]`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := json.Marshal(tc.in)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+				return
+			}
+			if string(out) != tc.out {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestList_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out cursor.List
+		msg string
+	}{
+		"Default":      {msg: "unexpected end of JSON input"},
+		"Blank":        {in: `[]`, out: cursor.List{}},
+		"Object":       {in: `{}`, msg: "list: json: cannot unmarshal object"},
+		"Unregistered": {in: `[["region","idf"]]`, msg: `list: value 1: unregistered type tag "region"`},
+		"Malformed":    {in: `[["int64"]]`, msg: "list: value 1: got 1 elements, exp a type tag and a value"},
+		"Untagged": {
+			in:  `["fr",3]`,
+			out: cursor.List{cursor.String("fr"), cursor.Int64(next)},
+		},
+		"Partially tagged": {
+			in:  `["idf",["int64",3]]`,
+			out: cursor.List{cursor.String("idf"), cursor.Int64(next)},
+		},
+		"OK": {
+			in:  `[["country","fr"],["decimal","19.99"],["int64",3]]`,
+			out: cursor.List{country("fr"), cursor.Decimal("19.99"), cursor.Int64(next)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out cursor.List
+			err := json.Unmarshal([]byte(tc.in), &out)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestDecrypt_List(t *testing.T) {
+	t.Parallel()
+
+	var (
+		prv = cursor.List{cursor.NewTime(instant, cursor.Microsecond), cursor.Int64(prev)}
+		in  = &cursor.Cursor[cursor.List]{Limit: limit, Prev: &prv, Next: new(cursor.List)}
+	)
+	b, err := cursor.Encrypt(in, []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := cursor.Decrypt[cursor.List](b, []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out.Prev, in.Prev) {
+		t.Errorf("\ngot %#v\nexp %#v", out.Prev, in.Prev)
+	}
+	if out.Next == nil || !out.Next.IsZero() {
+		t.Errorf("\ngot %#v\nexp last page", out.Next)
+	}
+}
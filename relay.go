@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+var (
+	errFirstAndLast   = errors.New("first and last can not be used together")
+	errAfterAndBefore = errors.New("after and before can not be used together")
+	errFirstAndBefore = errors.New("first can not be used with before")
+	errLastAndAfter   = errors.New("last can not be used with after")
+	errNegativeFirst  = errors.New("first must be a non-negative integer")
+	errNegativeLast   = errors.New("last must be a non-negative integer")
+)
+
+// RelayArgs are the arguments of a Relay Cursor Connection, used to paginate forward with first and after,
+// or backward with last and before.
+type RelayArgs struct {
+	First  *int
+	After  *string
+	Last   *int
+	Before *string
+}
+
+// Validate returns an error if the arguments can not be used together.
+func (a RelayArgs) Validate() error {
+	switch {
+	case a.First != nil && a.Last != nil:
+		return errFirstAndLast
+	case a.After != nil && a.Before != nil:
+		return errAfterAndBefore
+	case a.First != nil && a.Before != nil:
+		return errFirstAndBefore
+	case a.Last != nil && a.After != nil:
+		return errLastAndAfter
+	case a.First != nil && *a.First < 0:
+		return errNegativeFirst
+	case a.Last != nil && *a.Last < 0:
+		return errNegativeLast
+	}
+	return nil
+}
+
+func (a RelayArgs) backward() bool {
+	return a.Last != nil || a.Before != nil
+}
+
+func (a RelayArgs) limit(defaultLimit int) int {
+	switch {
+	case a.First != nil:
+		return *a.First
+	case a.Last != nil:
+		return *a.Last
+	default:
+		return defaultLimit
+	}
+}
+
+// NewRelayQuery validates the arguments and builds the Statement to fetch the edges,
+// based on the default limit when neither first nor last is given, and on the display order.
+// The after and before cursors are decrypted with the secret, or only decoded without secret.
+func NewRelayQuery[T Pointer](args RelayArgs, defaultLimit int, desc bool, secret []byte) (*RelayQuery[T], error) {
+	err := args.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("relay: %w", err)
+	}
+	q := &RelayQuery[T]{
+		args:   args,
+		limit:  args.limit(defaultLimit),
+		secret: secret,
+	}
+	c := &Cursor[T]{Limit: q.limit}
+	token := args.After
+	if args.backward() {
+		token = args.Before
+	}
+	if token != nil {
+		edge, err := decryptOrDecode[T]([]byte(*token), secret)
+		if err != nil {
+			return nil, fmt.Errorf("relay: %w", err)
+		}
+		c.Prev = edge.Prev
+		c.Filters = edge.Filters
+	}
+	// The rows after an edge are the rows before it with the opposite order.
+	// Without edge, the rows are fetched from the end to paginate backward.
+	q.Statement = Statement[T]{
+		Cursor:          c,
+		DescendingOrder: desc != (args.After != nil || (args.backward() && args.Before == nil)),
+	}
+	return q, nil
+}
+
+// RelayQuery is the query of a Relay Cursor Connection.
+type RelayQuery[T Pointer] struct {
+	// Statement is the statement to use to fetch the edges.
+	// When paginating backward, its rows are fetched in the reverse order.
+	Statement Statement[T]
+
+	args   RelayArgs
+	limit  int
+	secret []byte
+}
+
+// NewConnection returns the Relay Cursor Connection of these rows, fetched with the Statement of the query,
+// and the function returning the key of a row.
+// Rows can contain an extra row to tell if there are more edges. It is trimmed, and rows are put back in order.
+func NewConnection[T Pointer, R any](q *RelayQuery[T], rows []R, key func(R) T) (*Connection[R], error) {
+	if q == nil {
+		return nil, errors.New("relay: missing query")
+	}
+	var (
+		n    = min(len(rows), q.limit)
+		more = len(rows) > q.limit
+		c    = Connection[R]{Edges: make([]Edge[R], n)}
+	)
+	backward := q.args.backward()
+	for k := range n {
+		i := k
+		if backward {
+			i = n - 1 - k
+		}
+		token, err := relayCursor(key(rows[k]), q.Statement.Cursor.Filters, q.secret)
+		if err != nil {
+			return nil, fmt.Errorf("relay: %w", err)
+		}
+		c.Edges[i] = Edge[R]{Node: rows[k], Cursor: token}
+	}
+	if backward {
+		c.PageInfo.HasPreviousPage = more
+		c.PageInfo.HasNextPage = q.args.Before != nil
+	} else {
+		c.PageInfo.HasNextPage = more
+		c.PageInfo.HasPreviousPage = q.args.After != nil
+	}
+	if n > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[n-1].Cursor
+	}
+	return &c, nil
+}
+
+// Connection is a Relay Cursor Connection.
+type Connection[R any] struct {
+	Edges    []Edge[R] `json:"edges"`
+	PageInfo PageInfo  `json:"pageInfo"`
+}
+
+// Edge is an edge of a Relay Cursor Connection.
+type Edge[R any] struct {
+	Node   R      `json:"node"`
+	Cursor string `json:"cursor"`
+}
+
+// PageInfo contains the information about the page of a Relay Cursor Connection.
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+// relayCursor returns the cursor of an edge, pointing to its key.
+func relayCursor[T Pointer](key T, filters url.Values, secret []byte) (string, error) {
+	c := &Cursor[T]{Prev: &key, Filters: filters}
+	if len(secret) == 0 {
+		return c.String(), nil
+	}
+	return encryptString(c, secret)
+}
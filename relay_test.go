@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rvflash/cursor"
+)
+
+func TestRelayArgs_Validate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		one   = 1
+		minus = -1
+		token = "eyJwcmV2Ijo1fQ"
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		in cursor.RelayArgs
+		// outputs
+		msg string
+	}{
+		"Default":          {},
+		"First and last":   {in: cursor.RelayArgs{First: &one, Last: &one}, msg: "first and last can not be used together"},
+		"After and before": {in: cursor.RelayArgs{After: &token, Before: &token}, msg: "after and before"},
+		"First and before": {in: cursor.RelayArgs{First: &one, Before: &token}, msg: "first can not be used with before"},
+		"Last and after":   {in: cursor.RelayArgs{Last: &one, After: &token}, msg: "last can not be used with after"},
+		"Negative first":   {in: cursor.RelayArgs{First: &minus}, msg: "first must be a non-negative integer"},
+		"Negative last":    {in: cursor.RelayArgs{Last: &minus}, msg: "last must be a non-negative integer"},
+		"Forward":          {in: cursor.RelayArgs{First: &one, After: &token}},
+		"Backward":         {in: cursor.RelayArgs{Last: &one, Before: &token}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.in.Validate()
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+		})
+	}
+}
+
+func TestNewRelayQuery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		two   = limit
+		key   = cursor.Int64(next)
+		edge  = (&cursor.Cursor[cursor.Int64]{Prev: &key}).String()
+		wrong = "eyJwcmV2Ijo"
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		args cursor.RelayArgs
+		desc bool
+		// outputs
+		where   string
+		values  []any
+		orderBy string
+		limit   int
+		msg     string
+	}{
+		"Default":      {orderBy: " id ASC", limit: 11},
+		"Invalid":      {args: cursor.RelayArgs{First: &two, Last: &two}, msg: "relay: first and last"},
+		"Invalid edge": {args: cursor.RelayArgs{After: &wrong}, msg: "relay: "},
+		"First":        {args: cursor.RelayArgs{First: &two}, orderBy: " id ASC", limit: limit + 1},
+		"First desc":   {args: cursor.RelayArgs{First: &two}, desc: true, orderBy: " id DESC", limit: limit + 1},
+		"After": {
+			args:    cursor.RelayArgs{First: &two, After: &edge},
+			where:   " AND id > ?",
+			values:  []any{key},
+			orderBy: " id ASC",
+			limit:   limit + 1,
+		},
+		"After desc": {
+			args:    cursor.RelayArgs{First: &two, After: &edge},
+			desc:    true,
+			where:   " AND id < ?",
+			values:  []any{key},
+			orderBy: " id DESC",
+			limit:   limit + 1,
+		},
+		"Last":      {args: cursor.RelayArgs{Last: &two}, orderBy: " id DESC", limit: limit + 1},
+		"Last desc": {args: cursor.RelayArgs{Last: &two}, desc: true, orderBy: " id ASC", limit: limit + 1},
+		"Before": {
+			args:    cursor.RelayArgs{Last: &two, Before: &edge},
+			where:   " AND id < ?",
+			values:  []any{key},
+			orderBy: " id DESC",
+			limit:   limit + 1,
+		},
+		"Before desc": {
+			args:    cursor.RelayArgs{Last: &two, Before: &edge},
+			desc:    true,
+			where:   " AND id > ?",
+			values:  []any{key},
+			orderBy: " id ASC",
+			limit:   limit + 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			q, err := cursor.NewRelayQuery[cursor.Int64](tc.args, total, tc.desc, nil)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+				return
+			}
+			where, args := q.Statement.WhereCondition("id")
+			if where != tc.where || !reflect.DeepEqual(args, tc.values) {
+				t.Errorf("\ngot %q %#v\nexp %q %#v", where, args, tc.where, tc.values)
+			}
+			if out := q.Statement.OrderBy("id"); out != tc.orderBy {
+				t.Errorf("\ngot %q\nexp %q", out, tc.orderBy)
+			}
+			if out := q.Statement.Limit(); out != tc.limit {
+				t.Errorf("\ngot %d\nexp %d", out, tc.limit)
+			}
+		})
+	}
+}
+
+func TestNewConnection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		two  = limit
+		key  = cursor.Int64(next)
+		edge = mustEncrypt(t, &cursor.Cursor[cursor.Int64]{Prev: &key})
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		args cursor.RelayArgs
+		rows []int64
+		// outputs
+		nodes       []int64
+		hasNext     bool
+		hasPrevious bool
+	}{
+		"Default":         {nodes: []int64{}},
+		"First":           {args: cursor.RelayArgs{First: &two}, rows: []int64{1, 2, 3}, nodes: []int64{1, 2}, hasNext: true},
+		"First end":       {args: cursor.RelayArgs{First: &two}, rows: []int64{1, 2}, nodes: []int64{1, 2}},
+		"After":           {args: cursor.RelayArgs{First: &two, After: &edge}, rows: []int64{4, 5}, nodes: []int64{4, 5}, hasPrevious: true},
+		"Last":            {args: cursor.RelayArgs{Last: &two}, rows: []int64{10, 9, 8}, nodes: []int64{9, 10}, hasPrevious: true},
+		"Before":          {args: cursor.RelayArgs{Last: &two, Before: &edge}, rows: []int64{2, 1}, nodes: []int64{1, 2}, hasNext: true},
+		"Before and more": {args: cursor.RelayArgs{Last: &two, Before: &edge}, rows: []int64{2, 1, 0}, nodes: []int64{1, 2}, hasNext: true, hasPrevious: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			q, err := cursor.NewRelayQuery[cursor.Int64](tc.args, total, false, []byte(secret))
+			if err != nil {
+				checkErr(t, err, "")
+				return
+			}
+			c, err := cursor.NewConnection(q, tc.rows, func(r int64) cursor.Int64 { return cursor.Int64(r) })
+			if err != nil {
+				checkErr(t, err, "")
+				return
+			}
+			nodes := make([]int64, len(c.Edges))
+			for k, e := range c.Edges {
+				nodes[k] = e.Node
+				ec, err := cursor.Decrypt[cursor.Int64]([]byte(e.Cursor), []byte(secret))
+				if err != nil {
+					checkErr(t, err, "")
+					continue
+				}
+				if ec.Prev == nil || int64(*ec.Prev) != e.Node {
+					t.Errorf("\ngot %#v\nexp %d", ec.Prev, e.Node)
+				}
+			}
+			if !reflect.DeepEqual(nodes, tc.nodes) {
+				t.Errorf("\ngot %#v\nexp %#v", nodes, tc.nodes)
+			}
+			p := c.PageInfo
+			if p.HasNextPage != tc.hasNext || p.HasPreviousPage != tc.hasPrevious {
+				t.Errorf("\ngot %t %t\nexp %t %t", p.HasNextPage, p.HasPreviousPage, tc.hasNext, tc.hasPrevious)
+			}
+			if len(c.Edges) == 0 {
+				if p.StartCursor != nil || p.EndCursor != nil {
+					t.Errorf("\ngot %#v %#v\nexp nil", p.StartCursor, p.EndCursor)
+				}
+				return
+			}
+			if *p.StartCursor != c.Edges[0].Cursor || *p.EndCursor != c.Edges[len(c.Edges)-1].Cursor {
+				t.Errorf("\ngot %q %q\nexp start and end edges", *p.StartCursor, *p.EndCursor)
+			}
+		})
+	}
+}
+
+func TestNewConnection_MissingQuery(t *testing.T) {
+	t.Parallel()
+
+	_, err := cursor.NewConnection[cursor.Int64](nil, []int64{1}, func(r int64) cursor.Int64 { return cursor.Int64(r) })
+	checkErr(t, err, "relay: missing query")
+}
+
+func mustEncrypt(t *testing.T, c *cursor.Cursor[cursor.Int64]) string {
+	t.Helper()
+	b, err := cursor.Encrypt(c, []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// NewHAL returns the HAL links to navigate from the request r, using param as cursor query parameter,
+// and the metadata of the cursor. The links are absolute, the scheme and host being taken from origin if not nil,
+// to be used behind a proxy, otherwise from the request. The other query parameters of the request are kept.
+func NewHAL[T Pointer](c *Cursor[T], p *Pagination, r *http.Request, origin *url.URL, param string) *HAL {
+	if p == nil {
+		p = &Pagination{}
+	}
+	var (
+		h HAL
+		u = baseURL(r, origin)
+	)
+	if r != nil {
+		h.Links.Self = &HALLink{Href: u.String()}
+	}
+	h.Links.First = newHALLink(&u, param, p.First)
+	h.Links.Prev = newHALLink(&u, param, p.Prev)
+	h.Links.Next = newHALLink(&u, param, p.Next)
+	h.Links.Last = newHALLink(&u, param, p.Last)
+	h.Meta = NewMeta(c)
+	return &h
+}
+
+// HAL contains the links to navigate following the Hypertext Application Language specification,
+// with the metadata of the cursor. It can be embedded in a resource to add the _links and meta objects.
+type HAL struct {
+	Links HALLinks `json:"_links"`
+	Meta  *Meta    `json:"meta,omitempty"`
+}
+
+// HALLinks contains the HAL links to navigate, the unavailable ones are omitted.
+type HALLinks struct {
+	Self  *HALLink `json:"self,omitempty"`
+	First *HALLink `json:"first,omitempty"`
+	Prev  *HALLink `json:"prev,omitempty"`
+	Next  *HALLink `json:"next,omitempty"`
+	Last  *HALLink `json:"last,omitempty"`
+}
+
+// HALLink is a HAL link object.
+type HALLink struct {
+	Href string `json:"href"`
+}
+
+func newHALLink(u *url.URL, param, token string) *HALLink {
+	if token == "" {
+		return nil
+	}
+	return &HALLink{Href: pageURL(u, param, token)}
+}
+
+// NewJSONAPI returns the JSON:API links to navigate from the request r, using param as cursor query parameter,
+// and the metadata of the cursor. The links are absolute, the scheme and host being taken from origin if not nil,
+// to be used behind a proxy, otherwise from the request. The other query parameters of the request are kept.
+func NewJSONAPI[T Pointer](c *Cursor[T], p *Pagination, r *http.Request, origin *url.URL, param string) *JSONAPI {
+	if p == nil {
+		p = &Pagination{}
+	}
+	var (
+		j JSONAPI
+		u = baseURL(r, origin)
+	)
+	if r != nil {
+		j.Links.Self = u.String()
+	}
+	j.Links.First = newJSONAPILink(&u, param, p.First)
+	j.Links.Prev = newJSONAPILink(&u, param, p.Prev)
+	j.Links.Next = newJSONAPILink(&u, param, p.Next)
+	j.Links.Last = newJSONAPILink(&u, param, p.Last)
+	j.Meta = NewMeta(c)
+	return &j
+}
+
+// JSONAPI contains the top-level links and meta members of a JSON:API document.
+// It can be embedded in a document alongside its data.
+type JSONAPI struct {
+	Links JSONAPILinks `json:"links"`
+	Meta  *Meta        `json:"meta,omitempty"`
+}
+
+// JSONAPILinks contains the JSON:API pagination links.
+// As required by the specification, the unavailable ones are null.
+type JSONAPILinks struct {
+	Self  string  `json:"self,omitempty"`
+	First *string `json:"first"`
+	Prev  *string `json:"prev"`
+	Next  *string `json:"next"`
+	Last  *string `json:"last"`
+}
+
+func newJSONAPILink(u *url.URL, param, token string) *string {
+	if token == "" {
+		return nil
+	}
+	s := pageURL(u, param, token)
+	return &s
+}
+
+// NewMeta returns the metadata of the cursor.
+func NewMeta[T Pointer](c *Cursor[T]) *Meta {
+	if c == nil {
+		return nil
+	}
+	m := Meta{
+		CurrentPage: c.CurrentPage(),
+		Limit:       c.Limit,
+		HasNext:     Next(c) != nil,
+	}
+	if n := c.TotalItems(); n > notFound {
+		m.TotalItems = &n
+	}
+	if n := c.TotalPages(); n > notFound {
+		m.TotalPages = &n
+	}
+	return &m
+}
+
+// Meta contains the metadata of a cursor.
+// The unknown totals are omitted.
+type Meta struct {
+	CurrentPage int  `json:"current_page"`
+	TotalPages  *int `json:"total_pages,omitempty"`
+	TotalItems  *int `json:"total_items,omitempty"`
+	Limit       int  `json:"limit"`
+	HasNext     bool `json:"has_next"`
+}
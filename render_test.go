@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/rvflash/cursor"
+)
+
+const requestURL = "https://api.example.com/users?sort=name&cursor=old"
+
+func TestNewHAL(t *testing.T) {
+	t.Parallel()
+
+	origin, err := url.Parse("https://api.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := total
+	for name, tc := range map[string]struct {
+		// inputs
+		cursor *cursor.Cursor[cursor.Int64]
+		in     *cursor.Pagination
+		r      *http.Request
+		origin *url.URL
+		// outputs
+		out string
+	}{
+		"Default": {out: `{"_links":{}}`},
+		"Blank": {
+			in:  &cursor.Pagination{},
+			r:   httptest.NewRequest(http.MethodGet, requestURL, nil),
+			out: `{"_links":{"self":{"href":"` + requestURL + `"}}}`,
+		},
+		"Request": {
+			cursor: &cursor.Cursor[cursor.Int64]{Limit: limit},
+			in:     &cursor.Pagination{Next: tokenNext},
+			r:      httptest.NewRequest(http.MethodGet, "/users?sort=name&cursor=old", nil),
+			out: `{"_links":{"self":{"href":"http://example.com/users?sort=name&cursor=old"},` +
+				`"next":{"href":"http://example.com/users?cursor=` + tokenNext + `&sort=name"}},` +
+				`"meta":{"current_page":1,"limit":2,"has_next":false}}`,
+		},
+		"OK": {
+			cursor: &cursor.Cursor[cursor.Int64]{Limit: limit, Offset: limit, Total: &sum},
+			in:     &cursor.Pagination{Next: tokenNext, Last: tokenLast},
+			r:      httptest.NewRequest(http.MethodGet, "/users?sort=name&cursor=old", nil),
+			origin: origin,
+			out: `{"_links":{"self":{"href":"` + requestURL + `"},` +
+				`"next":{"href":"https://api.example.com/users?cursor=` + tokenNext + `&sort=name"},` +
+				`"last":{"href":"https://api.example.com/users?cursor=` + tokenLast + `&sort=name"}},` +
+				`"meta":{"current_page":2,"total_pages":5,"total_items":10,"limit":2,"has_next":false}}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := marshal(t, cursor.NewHAL(tc.cursor, tc.in, tc.r, tc.origin, "cursor"))
+			if out != tc.out {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestNewJSONAPI(t *testing.T) {
+	t.Parallel()
+
+	origin, err := url.Parse("https://api.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := total
+	for name, tc := range map[string]struct {
+		// inputs
+		cursor *cursor.Cursor[cursor.Int64]
+		in     *cursor.Pagination
+		r      *http.Request
+		origin *url.URL
+		// outputs
+		out string
+	}{
+		"Default": {
+			out: `{"links":{"first":null,"prev":null,"next":null,"last":null}}`,
+		},
+		"Unknown total": {
+			cursor: &cursor.Cursor[cursor.Int64]{Limit: limit},
+			in:     &cursor.Pagination{Next: tokenNext},
+			r:      httptest.NewRequest(http.MethodGet, requestURL, nil),
+			out: `{"links":{"self":"` + requestURL + `","first":null,"prev":null,` +
+				`"next":"https://api.example.com/users?cursor=` + tokenNext + `&sort=name","last":null},` +
+				`"meta":{"current_page":1,"limit":2,"has_next":false}}`,
+		},
+		"Request": {
+			in: &cursor.Pagination{Next: tokenNext},
+			r:  httptest.NewRequest(http.MethodGet, "/users?sort=name&cursor=old", nil),
+			out: `{"links":{"self":"http://example.com/users?sort=name&cursor=old","first":null,"prev":null,` +
+				`"next":"http://example.com/users?cursor=` + tokenNext + `&sort=name","last":null}}`,
+		},
+		"OK": {
+			cursor: &cursor.Cursor[cursor.Int64]{Limit: limit, Offset: limit, Total: &sum},
+			in:     &cursor.Pagination{First: tokenFirst},
+			r:      httptest.NewRequest(http.MethodGet, "/users?sort=name&cursor=old", nil),
+			origin: origin,
+			out: `{"links":{"self":"` + requestURL + `",` +
+				`"first":"https://api.example.com/users?cursor=` + tokenFirst + `&sort=name",` +
+				`"prev":null,"next":null,"last":null},` +
+				`"meta":{"current_page":2,"total_pages":5,"total_items":10,"limit":2,"has_next":false}}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := marshal(t, cursor.NewJSONAPI(tc.cursor, tc.in, tc.r, tc.origin, "cursor"))
+			if out != tc.out {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+		})
+	}
+}
+
+// marshal returns the JSON encoding of v, without escaping the HTML characters of the URLs.
+func marshal(t *testing.T, v any) string {
+	t.Helper()
+
+	buf := new(strings.Builder)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// Slice applies the statement's cursor to the rows, sorted in ascending order by their key with cmp,
+// and returns the rows of the page in display order, defined by the statement.
+// The key function returns the pointer of a row. As with Query, the cursor of the statement is updated
+// with these rows and returned, ready to be used by Paginate.
+func Slice[T Pointer, R any](st Statement[T], rows []R, key func(R) T, cmp func(a, b T) int) ([]R, *Cursor[T], error) {
+	page, err := SliceSource[T, R]{Rows: rows, Key: key, Compare: cmp}.Fetch(context.Background(), st)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]T, len(page))
+	for k, r := range page {
+		keys[k] = key(r)
+	}
+	return collect(st, page, keys), st.Cursor, nil
+}
+
+// SliceSource is a Source paginating the rows held in memory, sorted in ascending order by their key with Compare.
+type SliceSource[T Pointer, R any] struct {
+	// Rows are the sorted rows.
+	Rows []R
+	// Key returns the pointer of a row.
+	Key func(R) T
+	// Compare compares two pointers, such as Compare.
+	Compare func(a, b T) int
+}
+
+// Fetch implements the Source interface.
+func (s SliceSource[T, R]) Fetch(_ context.Context, st Statement[T]) ([]R, error) {
+	if st.Cursor == nil || st.Cursor.Limit == 0 {
+		return nil, fmt.Errorf("slice: %w", errMissingLimit)
+	}
+	var (
+		c   = st.Cursor
+		n   = len(s.Rows)
+		lo  = 0
+		hi  = n
+		asc = st.DescendingOrder == st.reversed()
+	)
+	// lower returns the index of the first row not before p, upper the one of the first row after it.
+	lower := func(p T) int {
+		return sort.Search(n, func(i int) bool { return s.Compare(s.Key(s.Rows[i]), p) >= 0 })
+	}
+	upper := func(p T) int {
+		return sort.Search(n, func(i int) bool { return s.Compare(s.Key(s.Rows[i]), p) > 0 })
+	}
+	switch {
+	case c.Window && c.Prev != nil && c.Next != nil:
+		if st.DescendingOrder {
+			lo, hi = lower(*c.Next), upper(*c.Prev)
+		} else {
+			lo, hi = lower(*c.Prev), upper(*c.Next)
+		}
+	case c.Next != nil && !(*c.Next).IsZero():
+		// Next page, from the pointer included.
+		if st.DescendingOrder {
+			hi = upper(*c.Next)
+		} else {
+			lo = lower(*c.Next)
+		}
+	case c.Prev != nil && !(*c.Prev).IsZero():
+		// Previous page, before the pointer.
+		if st.DescendingOrder {
+			lo = upper(*c.Prev)
+		} else {
+			hi = lower(*c.Prev)
+		}
+	}
+	var (
+		size = max(0, min(hi-lo-st.Offset(), st.Limit()))
+		page = make([]R, 0, size)
+	)
+	for k := st.Offset(); k < hi-lo && len(page) < size; k++ {
+		i := lo + k
+		if !asc {
+			i = hi - 1 - k
+		}
+		page = append(page, s.Rows[i])
+	}
+	return page, nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+
+	"github.com/rvflash/cursor"
+)
+
+func TestSlice(t *testing.T) {
+	t.Parallel()
+
+	type move func(*cursor.Cursor[cursor.Int64]) *cursor.Cursor[cursor.Int64]
+	var (
+		sum  = len(ids)
+		page = func(n int) move {
+			return func(c *cursor.Cursor[cursor.Int64]) *cursor.Cursor[cursor.Int64] { return cursor.Page(c, n) }
+		}
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		desc  bool
+		moves []move
+		// outputs
+		out    [][]int64
+		offset int
+	}{
+		"Default": {out: [][]int64{{1, 2}}},
+		"Forward": {
+			moves:  []move{cursor.Next[cursor.Int64], cursor.Next[cursor.Int64]},
+			out:    [][]int64{{1, 2}, {3, 4}, {5}},
+			offset: limit * 2,
+		},
+		"Backward": {
+			moves:  []move{cursor.Last[cursor.Int64], cursor.Prev[cursor.Int64], cursor.Prev[cursor.Int64]},
+			out:    [][]int64{{1, 2}, {5}, {3, 4}, {1, 2}},
+			offset: 0,
+		},
+		"Descending": {
+			desc:   true,
+			moves:  []move{cursor.Next[cursor.Int64], cursor.Prev[cursor.Int64], cursor.Last[cursor.Int64]},
+			out:    [][]int64{{5, 4}, {3, 2}, {5, 4}, {1}},
+			offset: limit * 2,
+		},
+		"Self": {
+			moves:  []move{cursor.Next[cursor.Int64], cursor.Self[cursor.Int64]},
+			out:    [][]int64{{1, 2}, {3, 4}, {3, 4}},
+			offset: limit,
+		},
+		"Page": {
+			moves:  []move{page(2), cursor.First[cursor.Int64], page(3), page(2), cursor.Prev[cursor.Int64]},
+			out:    [][]int64{{1, 2}, {3, 4}, {1, 2}, {5}, {3, 4}, {1, 2}},
+			offset: 0,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				c   = cursor.New[cursor.Int64](limit, sum)
+				out [][]int64
+			)
+			for k := 0; k <= len(tc.moves); k++ {
+				if k > 0 {
+					if c = tc.moves[k-1](c); c == nil {
+						break
+					}
+				}
+				var (
+					rows []int64
+					err  error
+				)
+				rows, c, err = cursor.Slice(cursor.Statement[cursor.Int64]{Cursor: c, DescendingOrder: tc.desc}, ids,
+					func(v int64) cursor.Int64 { return cursor.Int64(v) },
+					func(a, b cursor.Int64) int { return cmp.Compare(a, b) },
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+				out = append(out, rows)
+			}
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+			if c == nil || c.Offset != tc.offset || c.CurrentPage() != tc.offset/limit+1 {
+				t.Errorf("\ngot %#v\nexp offset %d", c, tc.offset)
+			}
+		})
+	}
+}
+
+func TestSlice_Page(t *testing.T) {
+	t.Parallel()
+
+	const size = 5
+	rows := make([]int64, 50)
+	for k := range rows {
+		rows[k] = int64(k + 1)
+	}
+	var (
+		c = cursor.New[cursor.Int64](size, len(rows))
+		// Jump forward to page 7, back to page 5, then walk from it.
+		moves = []func(*cursor.Cursor[cursor.Int64]) *cursor.Cursor[cursor.Int64]{
+			func(c *cursor.Cursor[cursor.Int64]) *cursor.Cursor[cursor.Int64] { return cursor.Page(c, 7) },
+			func(c *cursor.Cursor[cursor.Int64]) *cursor.Cursor[cursor.Int64] { return cursor.Page(c, 5) },
+			cursor.Next[cursor.Int64],
+			cursor.Prev[cursor.Int64],
+			cursor.Prev[cursor.Int64],
+			cursor.Next[cursor.Int64],
+			cursor.Next[cursor.Int64],
+		}
+		pages = []int{1, 7, 5, 6, 5, 4, 5, 6}
+	)
+	for k := 0; k <= len(moves); k++ {
+		if k > 0 {
+			if c = moves[k-1](c); c == nil {
+				t.Fatalf("move %d: missing cursor", k)
+			}
+		}
+		out, d, err := cursor.Slice(cursor.Statement[cursor.Int64]{Cursor: c}, rows,
+			func(v int64) cursor.Int64 { return cursor.Int64(v) },
+			func(a, b cursor.Int64) int { return cmp.Compare(a, b) },
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c = d
+		exp := rows[(pages[k]-1)*size : pages[k]*size]
+		if !reflect.DeepEqual(out, exp) {
+			t.Errorf("move %d:\ngot %#v\nexp %#v", k, out, exp)
+		}
+		if p := c.CurrentPage(); p != pages[k] {
+			t.Errorf("move %d: page\ngot %d\nexp %d", k, p, pages[k])
+		}
+	}
+}
+
+func TestSlice_MissingLimit(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := cursor.Slice(cursor.Statement[cursor.Int64]{}, ids,
+		func(v int64) cursor.Int64 { return cursor.Int64(v) },
+		func(a, b cursor.Int64) int { return cmp.Compare(a, b) },
+	)
+	checkErr(t, err, "slice: missing limit")
+}
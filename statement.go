@@ -6,6 +6,7 @@ package cursor
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -14,8 +15,32 @@ const (
 	beforeExpr    = "<"
 	afterExpr     = ">"
 	equalExpr     = "="
+	isNullExpr    = "IS NULL"
+	isNotNullExpr = "IS NOT NULL"
+	trueExpr      = "TRUE"
+	falseExpr     = "FALSE"
+	betweenExpr   = "BETWEEN"
+	andExpr       = "AND"
 )
 
+// Column describes a column used to sort and filter the rows.
+type Column struct {
+	// Name is the name of the column, as used in the SQL statement.
+	Name string
+	// Desc defines the descending order of the column.
+	Desc bool
+}
+
+// Sorter can be implemented by a Pointer to provide its columns and their sort order.
+// Statement always uses them: the columns given, if any, only name them in the same order,
+// such as to qualify them with the table, and the ones not matching are ignored.
+// DescendingOrder reverses the order of each column,
+// and the rows are filtered by comparing the columns as a whole, such as (a, b) > (?, ?) with mixed orders.
+type Sorter interface {
+	// Columns returns the columns of the pointer, in order.
+	Columns() []Column
+}
+
 // Statement allows building of SQL query for MySQL or MariaDB.
 // The idea is to build a SQL statement like this one to going forward and
 // returns results based on the cursor with descending order.
@@ -35,7 +60,8 @@ type Statement[T Pointer] struct {
 }
 
 // Limit returns the row count to restrict the number of returned rows.
-// The value is incremented by one to check if there is more to fetch.
+// The value is incremented by one to check if there is more to fetch,
+// and by one more to fetch backward the row starting the next page, see Offset.
 func (s Statement[T]) Limit() int {
 	if s.Cursor == nil || s.Cursor.Limit == 0 {
 		return 0
@@ -44,17 +70,47 @@ func (s Statement[T]) Limit() int {
 		// Last page requested.
 		return s.Cursor.Limit
 	}
-	return s.Cursor.Limit + 1
+	if s.skipBackward() {
+		return s.Cursor.size() + 2
+	}
+	return s.Cursor.size() + 1
+}
+
+// Offset returns the number of rows to skip, used to reach a page by its number.
+// Without pointer, it is the offset of the cursor. Otherwise, the rows are filtered by WhereCondition
+// and it returns the number of rows to skip from the pointer, 0 by default.
+// Skipping backward, it is one row fewer, to also fetch the row starting the next page.
+func (s Statement[T]) Offset() int {
+	if s.Cursor == nil {
+		return 0
+	}
+	if s.Cursor.isEmpty() {
+		return s.Cursor.Offset
+	}
+	if s.skipBackward() {
+		// The row before the skipped ones starts the next page.
+		return s.Cursor.Skip - 1
+	}
+	return s.Cursor.Skip
 }
 
 // OrderBy returns the clause to order the selected and limited resultset.
 // It differs from OrderBy to limit its scope to the WITH statement, also known as data source.
 func (s Statement[T]) OrderBy(columns ...string) string {
 	desc := s.DescendingOrder
-	if s.Cursor != nil &&
-		((s.Cursor.Prev != nil && !(*s.Cursor.Prev).IsZero()) || (s.Cursor.Next != nil && (*s.Cursor.Next).IsZero())) {
+	if s.reversed() {
 		desc = !desc
 	}
+	if cols := s.sortColumns(columns); len(cols) > 0 {
+		buf := new(strings.Builder)
+		for k := range cols {
+			if k > 0 {
+				_, _ = fmt.Fprint(buf, ",")
+			}
+			_, _ = fmt.Fprintf(buf, " %s%s", cols[k].Name, s.orderBy(desc != cols[k].Desc))
+		}
+		return buf.String()
+	}
 	if len(columns) > 0 {
 		buf := new(strings.Builder)
 		for k := range columns {
@@ -69,10 +125,16 @@ func (s Statement[T]) OrderBy(columns ...string) string {
 }
 
 // WhereCondition returns the condition that rows must satisfy to be selected.
+// With a window cursor, the rows are selected between its bounds, both included.
+// With a composite pointer, such as a Tuple, the columns are compared as a whole,
+// such as: a > ? OR (a = ? AND b >= ?).
 func (s Statement[T]) WhereCondition(columns ...string) (string, []any) {
 	if s.Cursor.isEmpty() {
 		return "", nil
 	}
+	if s.Cursor.Window {
+		return s.windowCondition(columns)
+	}
 	var p Pointer
 	if s.Cursor.Next != nil {
 		p = *s.Cursor.Next
@@ -82,27 +144,251 @@ func (s Statement[T]) WhereCondition(columns ...string) (string, []any) {
 	if p.IsZero() {
 		return "", nil
 	}
+	next := s.Cursor.Next != nil
+	return s.condition(columns, p, next, next)
+}
+
+// condition returns the condition to select the rows after the pointer, or before it,
+// and the pointer itself if equal.
+func (s Statement[T]) condition(columns []string, p Pointer, next, equal bool) (string, []any) {
+	if cols := s.sortColumns(columns); len(cols) > 0 {
+		return s.rowCondition(cols, p, next, equal)
+	}
+	if _, ok := p.(composite); ok && len(columns) > 0 {
+		// The values of a composite pointer, such as a Tuple, are compared as a whole with the columns.
+		cols := make([]Column, len(columns))
+		for k := range columns {
+			cols[k] = Column{Name: columns[k]}
+		}
+		return s.rowCondition(cols, p, next, equal)
+	}
 	if len(columns) > 0 {
-		buf := new(strings.Builder)
+		var (
+			buf  = new(strings.Builder)
+			args []any
+		)
 		for k := range columns {
-			_, _ = fmt.Fprintf(buf, " AND %s %s %s", columns[k], s.expr(), mysqlQueryArg)
+			cond, a := condition(columns[k], s.expr(next, equal), p, k)
+			_, _ = fmt.Fprintf(buf, " AND %s", cond)
+			args = append(args, a...)
 		}
-		return buf.String(), p.Args()
+		return buf.String(), args
 	}
-	return fmt.Sprintf(" %s %s", s.expr(), mysqlQueryArg), p.Args()
+	if n, ok := p.(nullable); ok && n.IsNull() {
+		return nullCondition(s.expr(next, equal))
+	}
+	return fmt.Sprintf(" %s %s", s.expr(next, equal), placeholder(p, 0)), p.Args()
 }
 
-func (s Statement[T]) expr() string {
-	if s.Cursor.Next != nil {
+// nullCondition returns the condition to append to a column to compare it with NULL, the smallest value.
+// Any row being after or equal to NULL, there is no condition in this case.
+func nullCondition(op string) (string, []any) {
+	switch op {
+	case afterExpr:
+		return " " + isNotNullExpr, nil
+	case afterExpr + equalExpr:
+		return "", nil
+	case beforeExpr:
+		return " " + isNullExpr + " AND " + falseExpr, nil
+	default:
+		return " " + isNullExpr, nil
+	}
+}
+
+func (s Statement[T]) expr(next, equal bool) string {
+	op := beforeExpr
+	if next != s.DescendingOrder {
+		op = afterExpr
+	}
+	if equal {
+		op += equalExpr
+	}
+	return op
+}
+
+// rowCondition returns the condition to compare the columns as a whole, with their own sort order.
+// For example, with (a DESC, b ASC) and the next page: a < ? OR (a = ? AND b >= ?).
+func (s Statement[T]) rowCondition(columns []Column, p Pointer, next, equal bool) (string, []any) {
+	var (
+		ors  = make([][]string, 0, len(columns))
+		args []any
+	)
+	for k := range columns {
+		var (
+			and = make([]string, 0, k+1)
+			a   []any
+		)
+		for i := range k {
+			cond, v := condition(columns[i].Name, equalExpr, p, i)
+			and = append(and, cond)
+			a = append(a, v...)
+		}
+		op := beforeExpr
+		if next == (columns[k].Desc == s.DescendingOrder) {
+			op = afterExpr
+		}
+		if equal && k == len(columns)-1 {
+			op += equalExpr
+		}
+		cond, v := condition(columns[k].Name, op, p, k)
+		if cond == falseExpr {
+			// No row is before a NULL value.
+			continue
+		}
+		ors = append(ors, append(and, cond))
+		args = append(args, append(a, v...)...)
+	}
+	switch len(ors) {
+	case 0:
+		return " AND " + falseExpr, nil
+	case 1:
+		return " AND " + strings.Join(ors[0], " AND "), args
+	}
+	buf := new(strings.Builder)
+	for k, and := range ors {
+		if k > 0 {
+			_, _ = fmt.Fprint(buf, " OR ")
+		}
+		if len(and) > 1 {
+			_, _ = fmt.Fprintf(buf, "(%s)", strings.Join(and, " AND "))
+		} else {
+			_, _ = fmt.Fprint(buf, and[0])
+		}
+	}
+	return " AND (" + buf.String() + ")", args
+}
+
+// windowCondition returns the condition to select the rows from the Prev bound to the Next one, both included.
+// Without column, it returns a BETWEEN condition, such as: BETWEEN ? AND ?.
+func (s Statement[T]) windowCondition(columns []string) (string, []any) {
+	if s.Cursor.Prev == nil || s.Cursor.Next == nil {
+		return "", nil
+	}
+	lower, upper := Pointer(*s.Cursor.Prev), Pointer(*s.Cursor.Next)
+	if len(columns) == 0 && len(s.sortColumns(columns)) == 0 {
 		if s.DescendingOrder {
-			return beforeExpr + equalExpr
+			lower, upper = upper, lower
 		}
-		return afterExpr + equalExpr
+		return fmt.Sprintf(" %s %s %s %s", betweenExpr, placeholder(lower, 0), andExpr, placeholder(upper, 0)),
+			append(lower.Args(), upper.Args()...)
 	}
-	if s.DescendingOrder {
-		return afterExpr
+	from, args := s.condition(columns, lower, true, true)
+	to, a := s.condition(columns, upper, false, true)
+	return from + to, append(args, a...)
+}
+
+// sortColumns returns the columns provided by the pointer, named by the given columns if they match them.
+func (s Statement[T]) sortColumns(columns []string) []Column {
+	cols, _ := s.sorterColumns(columns)
+	return cols
+}
+
+// sorterColumns returns the columns provided by the pointer, with their sort order.
+// The given columns name them, such as to qualify them with the table, and must match them.
+// Otherwise, it returns the columns of the pointer with an error.
+func (s Statement[T]) sorterColumns(columns []string) ([]Column, error) {
+	v, ok := any(*new(T)).(Sorter)
+	if !ok {
+		return nil, nil
+	}
+	cols := v.Columns()
+	if len(columns) == 0 {
+		return cols, nil
+	}
+	if len(columns) != len(cols) {
+		return cols, fmt.Errorf("%w: got %d columns, exp %d", errMismatchedColumns, len(columns), len(cols))
+	}
+	res := slices.Clone(cols)
+	for k := range columns {
+		if unqualified(columns[k]) != cols[k].Name && columns[k] != cols[k].Name {
+			return cols, fmt.Errorf("%w: got %s, exp %s", errMismatchedColumns, columns[k], cols[k].Name)
+		}
+		res[k].Name = columns[k]
 	}
-	return beforeExpr
+	return res, nil
+}
+
+// unqualified returns the name of the column without its table.
+func unqualified(column string) string {
+	if k := strings.LastIndexByte(column, '.'); k >= 0 {
+		return column[k+1:]
+	}
+	return column
+}
+
+// condition returns the condition comparing the column with the value k of the pointer.
+// With a Nullable value, NULL is considered as the smallest value.
+func condition(column, op string, p Pointer, k int) (string, []any) {
+	var (
+		ph  = placeholder(p, k)
+		arg = argAt(p, k)
+	)
+	n, ok := pointerAt(p, k).(nullable)
+	if !ok {
+		return fmt.Sprintf("%s %s %s", column, op, ph), []any{arg}
+	}
+	if !n.IsNull() {
+		switch op {
+		case beforeExpr, beforeExpr + equalExpr:
+			return fmt.Sprintf("(%s %s %s OR %s %s)", column, op, ph, column, isNullExpr), []any{arg}
+		default:
+			return fmt.Sprintf("%s %s %s", column, op, ph), []any{arg}
+		}
+	}
+	switch op {
+	case afterExpr:
+		return column + " " + isNotNullExpr, nil
+	case afterExpr + equalExpr:
+		return trueExpr, nil
+	case beforeExpr:
+		return falseExpr, nil
+	default:
+		return column + " " + isNullExpr, nil
+	}
+}
+
+func argAt(p Pointer, k int) any {
+	if c, ok := p.(composite); ok {
+		// The zero values of a composite pointer are ordinary values to compare with.
+		if l := c.pointers(); k < len(l) {
+			return valueOf(l[k])
+		}
+	}
+	switch a := p.Args(); {
+	case k < len(a):
+		return a[k]
+	case len(a) == 1:
+		return a[0]
+	default:
+		return nil
+	}
+}
+
+func pointerAt(p Pointer, k int) Pointer {
+	if c, ok := p.(composite); ok {
+		if l := c.pointers(); k < len(l) {
+			return l[k]
+		}
+	}
+	return p
+}
+
+func placeholder(p Pointer, k int) string {
+	if h, ok := pointerAt(p, k).(Placeholder); ok {
+		return h.Placeholder()
+	}
+	return mysqlQueryArg
+}
+
+// skipBackward returns true if the rows are skipped backward from the pointer, see Page.
+func (s Statement[T]) skipBackward() bool {
+	return s.reversed() && s.Cursor.Skip > 0
+}
+
+// reversed returns true if the rows are fetched in the reverse order, to get the previous or the last page.
+func (s Statement[T]) reversed() bool {
+	return s.Cursor != nil && !s.Cursor.Window &&
+		((s.Cursor.Prev != nil && !(*s.Cursor.Prev).IsZero()) || (s.Cursor.Next != nil && (*s.Cursor.Next).IsZero()))
 }
 
 func (s Statement[T]) orderBy(desc bool) string {
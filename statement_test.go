@@ -184,6 +184,7 @@ const (
 func TestStatement_Limit(t *testing.T) {
 	t.Parallel()
 
+	prv := cursor.Int64(prev)
 	for name, tc := range map[string]struct {
 		in  cursor.Statement[cursor.Int64]
 		out int
@@ -212,6 +213,16 @@ func TestStatement_Limit(t *testing.T) {
 			},
 			out: limit,
 		},
+		"Skip back": {
+			in: cursor.Statement[cursor.Int64]{
+				Cursor: &cursor.Cursor[cursor.Int64]{
+					Limit: limit,
+					Prev:  &prv,
+					Skip:  limit,
+				},
+			},
+			out: limit + 2,
+		},
 		"OK": {
 			in: cursor.Statement[cursor.Int64]{
 				Cursor: &cursor.Cursor[cursor.Int64]{
@@ -232,6 +243,34 @@ func TestStatement_Limit(t *testing.T) {
 	}
 }
 
+func TestStatement_Offset(t *testing.T) {
+	t.Parallel()
+
+	prv := cursor.Int64(prev)
+	for name, tc := range map[string]struct {
+		// inputs
+		in *cursor.Cursor[cursor.Int64]
+		// outputs
+		out int
+	}{
+		"Default":     {},
+		"First page":  {in: &cursor.Cursor[cursor.Int64]{Limit: limit}},
+		"Keyset page": {in: &cursor.Cursor[cursor.Int64]{Prev: &prv, Offset: limit, Limit: limit}},
+		"Skip":        {in: &cursor.Cursor[cursor.Int64]{Next: &prv, Offset: limit * 4, Skip: limit, Limit: limit}, out: limit},
+		"Skip back":   {in: &cursor.Cursor[cursor.Int64]{Prev: &prv, Offset: limit, Skip: limit, Limit: limit}, out: limit - 1},
+		"OK":          {in: &cursor.Cursor[cursor.Int64]{Offset: limit, Limit: limit}, out: limit},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			st := cursor.Statement[cursor.Int64]{Cursor: tc.in}
+			if out := st.Offset(); out != tc.out {
+				t.Errorf("\ngot %d\nexp %d", out, tc.out)
+			}
+		})
+	}
+}
+
 func TestStatement_OrderBy(t *testing.T) {
 	t.Parallel()
 
@@ -672,3 +711,111 @@ func TestStatement_WhereCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestStatement_WhereCondition_Placeholder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		price = cursor.Decimal("19.99")
+		list  = cursor.List{cursor.Decimal("19.99"), cursor.Int64(p3AscKey)}
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		in   cursor.Statement[cursor.List]
+		cols []string
+		// outputs
+		query string
+		args  []any
+	}{
+		"No column": {
+			in: cursor.Statement[cursor.List]{
+				Cursor: &cursor.Cursor[cursor.List]{
+					Limit: limit,
+					Next:  &cursor.List{price},
+				},
+			},
+			query: " >= CAST(? AS DECIMAL(65,30))",
+			args:  []any{"19.99"},
+		},
+		"Some columns": {
+			in: cursor.Statement[cursor.List]{
+				Cursor: &cursor.Cursor[cursor.List]{
+					Limit: limit,
+					Next:  &list,
+				},
+			},
+			cols:  []string{"price", "id"},
+			query: " AND (price > CAST(? AS DECIMAL(65,30)) OR (price = CAST(? AS DECIMAL(65,30)) AND id >= ?))",
+			args:  []any{"19.99", "19.99", cursor.Int64(p3AscKey)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			query, args := tc.in.WhereCondition(tc.cols...)
+			if query != tc.query {
+				t.Errorf("\ngot %s\nexp %s", query, tc.query)
+			}
+			if !reflect.DeepEqual(args, tc.args) {
+				t.Errorf("\ngot %#v\nexp %#v", args, tc.args)
+			}
+		})
+	}
+}
+
+func TestStatement_WhereCondition_Window(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ascP1K  = cursor.Int64(p1AscKey)
+		ascP3K  = cursor.Int64(p3AscKey)
+		descP1K = cursor.Int64(p1DescKey)
+		descP3K = cursor.Int64(p3DescKey)
+		window  = func(prev, next *cursor.Int64, desc bool) cursor.Statement[cursor.Int64] {
+			return cursor.Statement[cursor.Int64]{
+				Cursor:          &cursor.Cursor[cursor.Int64]{Prev: prev, Next: next, Limit: limit, Window: true},
+				DescendingOrder: desc,
+			}
+		}
+	)
+	for name, tc := range map[string]struct {
+		// inputs
+		in   cursor.Statement[cursor.Int64]
+		cols []string
+		// outputs
+		query string
+		args  []any
+	}{
+		"Default":       {in: window(nil, nil, false)},
+		"Missing bound": {in: window(&ascP1K, nil, false)},
+		"Ascending":     {in: window(&ascP1K, &ascP3K, false), query: " BETWEEN ? AND ?", args: []any{ascP1K, ascP3K}},
+		"Descending":    {in: window(&descP1K, &descP3K, true), query: " BETWEEN ? AND ?", args: []any{descP3K, descP1K}},
+		"Ascending - One column": {
+			in:    window(&ascP1K, &ascP3K, false),
+			cols:  []string{"id"},
+			query: " AND id >= ? AND id <= ?",
+			args:  []any{ascP1K, ascP3K},
+		},
+		"Descending - One column": {
+			in:    window(&descP1K, &descP3K, true),
+			cols:  []string{"id"},
+			query: " AND id <= ? AND id >= ?",
+			args:  []any{descP1K, descP3K},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			query, args := tc.in.WhereCondition(tc.cols...)
+			if query != tc.query {
+				t.Errorf("\ngot %s\nexp %s", query, tc.query)
+			}
+			if !reflect.DeepEqual(args, tc.args) {
+				t.Errorf("\ngot %#v\nexp %#v", args, tc.args)
+			}
+			if out, exp := tc.in.OrderBy(tc.cols...), window(nil, nil, tc.in.DescendingOrder).OrderBy(tc.cols...); out != exp {
+				t.Errorf("\ngot %s\nexp %s", out, exp)
+			}
+		})
+	}
+}
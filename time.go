@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Precision defines the fractional second precision of a Time pointer.
+type Precision uint8
+
+// List of supported precisions.
+const (
+	// Second matches the MySQL DATETIME or TIMESTAMP columns.
+	Second Precision = iota
+	// Millisecond matches the MySQL DATETIME(3) or TIMESTAMP(3) columns.
+	Millisecond
+	// Microsecond matches the MySQL DATETIME(6) or TIMESTAMP(6) columns.
+	Microsecond
+)
+
+var errPrecision = errors.New("unsupported precision")
+
+// digits returns the number of fractional digits of the precision.
+func (p Precision) digits() int {
+	switch p {
+	case Millisecond:
+		return 3
+	case Microsecond:
+		return 6
+	default:
+		return 0
+	}
+}
+
+// unit returns the duration of one unit of precision.
+func (p Precision) unit() time.Duration {
+	switch p {
+	case Millisecond:
+		return time.Millisecond
+	case Microsecond:
+		return time.Microsecond
+	default:
+		return time.Second
+	}
+}
+
+// time returns the local time corresponding to the number of units since the Unix epoch.
+func (p Precision) time(n int64) time.Time {
+	switch p {
+	case Millisecond:
+		return time.UnixMilli(n)
+	case Microsecond:
+		return time.UnixMicro(n)
+	default:
+		return time.Unix(n, 0)
+	}
+}
+
+// units returns the number of units elapsed since the Unix epoch.
+func (p Precision) units(t time.Time) int64 {
+	switch p {
+	case Millisecond:
+		return t.UnixMilli()
+	case Microsecond:
+		return t.UnixMicro()
+	default:
+		return t.Unix()
+	}
+}
+
+// NewTime returns a Time pointer based on t, normalized to UTC and truncated to the given precision.
+func NewTime(t time.Time, p Precision) Time {
+	if t.IsZero() {
+		return Time{}
+	}
+	return Time{
+		time:      t.UTC().Truncate(p.unit()),
+		precision: p,
+	}
+}
+
+// Time manages time pointer.
+// The instant is stored in UTC and encoded as a JSON number of seconds since the Unix epoch,
+// with as many fractional digits as required by its precision, such as 1762101336.123456.
+// The zero time is encoded as 0, so the Unix epoch can not be used as boundary.
+type Time struct {
+	time      time.Time
+	precision Precision
+}
+
+// Args implements the Pointer interface.
+func (t Time) Args() []any {
+	if t.IsZero() {
+		return nil
+	}
+	return []any{t.time}
+}
+
+// value implements the valuer interface.
+func (t Time) value() any {
+	return t.time
+}
+
+// Compare implements the Comparer interface.
+func (t Time) Compare(other Pointer) int {
+	return t.time.Compare(mustBe[Time](t, other).time)
+}
+
+// IsZero implements the Pointer interface.
+func (t Time) IsZero() bool {
+	return t.time.IsZero()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("0"), nil
+	}
+	var (
+		n = t.precision.units(t.time)
+		d = t.precision.digits()
+		b = make([]byte, 0, 24)
+	)
+	if n < 0 {
+		b = append(b, '-')
+		n = -n
+	}
+	s := strconv.FormatInt(n, 10)
+	if d == 0 {
+		return append(b, s...), nil
+	}
+	for len(s) <= d {
+		s = "0" + s
+	}
+	b = append(b, s[:len(s)-d]...)
+	b = append(b, '.')
+	return append(b, s[len(s)-d:]...), nil
+}
+
+// Precision returns the precision of the time.
+func (t Time) Precision() Precision {
+	return t.precision
+}
+
+// Time returns the time in UTC.
+func (t Time) Time() time.Time {
+	return t.time
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The precision is deduced from the number of fractional digits.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if bytes.Equal(data, []byte("0")) {
+		*t = Time{}
+		return nil
+	}
+	var (
+		s    = string(data)
+		neg  = len(s) > 0 && s[0] == '-'
+		p    Precision
+		frac string
+	)
+	if neg {
+		s = s[1:]
+	}
+	if i := strings.IndexByte(s, '.'); i > notFound {
+		s, frac = s[:i], s[i+1:]
+	}
+	switch len(frac) {
+	case 0:
+		p = Second
+	case Millisecond.digits():
+		p = Millisecond
+	case Microsecond.digits():
+		p = Microsecond
+	default:
+		return fmt.Errorf("time: %w: %q", errPrecision, data)
+	}
+	n, err := strconv.ParseInt(s+frac, 10, 64)
+	if err != nil || n < 0 {
+		return fmt.Errorf("time: invalid value: %q", data)
+	}
+	if neg {
+		n = -n
+	}
+	*t = Time{
+		time:      p.time(n).UTC(),
+		precision: p,
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rvflash/cursor"
+)
+
+var (
+	paris   = time.FixedZone("CET", 3600)
+	instant = time.Date(2025, 11, 2, 17, 35, 36, 123456789, paris)
+)
+
+func TestNewTime(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in        time.Time
+		precision cursor.Precision
+		// outputs
+		out time.Time
+	}{
+		"Default": {},
+		"Second": {
+			in:        instant,
+			precision: cursor.Second,
+			out:       time.Date(2025, 11, 2, 16, 35, 36, 0, time.UTC),
+		},
+		"Millisecond": {
+			in:        instant,
+			precision: cursor.Millisecond,
+			out:       time.Date(2025, 11, 2, 16, 35, 36, 123000000, time.UTC),
+		},
+		"Microsecond": {
+			in:        instant,
+			precision: cursor.Microsecond,
+			out:       time.Date(2025, 11, 2, 16, 35, 36, 123456000, time.UTC),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := cursor.NewTime(tc.in, tc.precision)
+			if !out.Time().Equal(tc.out) || out.Time().Location() != tc.out.Location() {
+				t.Errorf("\ngot %s\nexp %s", out.Time(), tc.out)
+			}
+		})
+	}
+}
+
+func TestTime_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Time
+		out []any
+	}{
+		"Default": {},
+		"OK": {
+			in:  cursor.NewTime(instant, cursor.Second),
+			out: []any{time.Date(2025, 11, 2, 16, 35, 36, 0, time.UTC)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestTime_IsZero(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Time
+		out bool
+	}{
+		"Default": {out: true},
+		"Zero":    {in: cursor.NewTime(time.Time{}, cursor.Microsecond), out: true},
+		"OK":      {in: cursor.NewTime(instant, cursor.Second)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.IsZero()
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestTime_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Time
+		out string
+	}{
+		"Default":     {out: "0"},
+		"Second":      {in: cursor.NewTime(instant, cursor.Second), out: "1762101336"},
+		"Millisecond": {in: cursor.NewTime(instant, cursor.Millisecond), out: "1762101336.123"},
+		"Microsecond": {in: cursor.NewTime(instant, cursor.Microsecond), out: "1762101336.123456"},
+		"Before epoch": {
+			in:  cursor.NewTime(time.Unix(-1, 250000000), cursor.Millisecond),
+			out: "-0.750",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := json.Marshal(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != tc.out {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out cursor.Time
+		msg string
+	}{
+		"Default":     {msg: "unexpected end of JSON input"},
+		"Zero":        {in: "0"},
+		"Null":        {in: "null"},
+		"Invalid":     {in: `"2025-11-02"`, msg: "time: invalid value"},
+		"Precision":   {in: "1762101336.1", msg: "time: unsupported precision"},
+		"Second":      {in: "1762101336", out: cursor.NewTime(instant, cursor.Second)},
+		"Millisecond": {in: "1762101336.123", out: cursor.NewTime(instant, cursor.Millisecond)},
+		"Microsecond": {in: "1762101336.123456", out: cursor.NewTime(instant, cursor.Microsecond)},
+		"Before epoch": {
+			in:  "-0.750",
+			out: cursor.NewTime(time.Unix(-1, 250000000), cursor.Millisecond),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out cursor.Time
+			err := json.Unmarshal([]byte(tc.in), &out)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestCursor_Decode_Time(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nxt = cursor.NewTime(instant, cursor.Microsecond)
+		in  = cursor.Cursor[cursor.Time]{Limit: limit, Next: &nxt}
+		out cursor.Cursor[cursor.Time]
+	)
+	b, err := in.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = out.Decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out.Next, in.Next) {
+		t.Errorf("\ngot %#v\nexp %#v", out.Next, in.Next)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NewTuple2 returns a Tuple2 based on these values.
+func NewTuple2[A, B Pointer](a A, b B) Tuple2[A, B] {
+	return Tuple2[A, B]{V1: a, V2: b}
+}
+
+// NewTuple3 returns a Tuple3 based on these values.
+func NewTuple3[A, B, C Pointer](a A, b B, c C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{V1: a, V2: b, V3: c}
+}
+
+// NewTuple4 returns a Tuple4 based on these values.
+func NewTuple4[A, B, C, D Pointer](a A, b B, c C, d D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{V1: a, V2: b, V3: c, V4: d}
+}
+
+// Tuple2 manages a composite pointer of two typed values, such as (created_at, id).
+// Unlike List, it is encoded as a JSON array and decoded back to the concrete types of its values.
+type Tuple2[A, B Pointer] struct {
+	V1 A
+	V2 B
+}
+
+// Args implements the Pointer interface.
+// Each value is an argument, even a zero one, such as a score of 0.
+func (t Tuple2[A, B]) Args() []any {
+	return values(t.pointers())
+}
+
+// Compare implements the Comparer interface.
+// Tuples are compared lexicographically.
+func (t Tuple2[A, B]) Compare(other Pointer) int {
+	return compareLists(t.pointers(), mustBe[Tuple2[A, B]](t, other).pointers())
+}
+
+// IsZero implements the Pointer interface.
+func (t Tuple2[A, B]) IsZero() bool {
+	return isZero(t.pointers())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Tuple2[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.V1, t.V2})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Tuple2[A, B]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v Tuple2[A, B]
+	err := unmarshalTuple(data, &v.V1, &v.V2)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+func (t Tuple2[A, B]) pointers() []Pointer {
+	return []Pointer{t.V1, t.V2}
+}
+
+// Tuple3 manages a composite pointer of three typed values.
+// Unlike List, it is encoded as a JSON array and decoded back to the concrete types of its values.
+type Tuple3[A, B, C Pointer] struct {
+	V1 A
+	V2 B
+	V3 C
+}
+
+// Args implements the Pointer interface.
+// Each value is an argument, even a zero one, such as a score of 0.
+func (t Tuple3[A, B, C]) Args() []any {
+	return values(t.pointers())
+}
+
+// Compare implements the Comparer interface.
+// Tuples are compared lexicographically.
+func (t Tuple3[A, B, C]) Compare(other Pointer) int {
+	return compareLists(t.pointers(), mustBe[Tuple3[A, B, C]](t, other).pointers())
+}
+
+// IsZero implements the Pointer interface.
+func (t Tuple3[A, B, C]) IsZero() bool {
+	return isZero(t.pointers())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Tuple3[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.V1, t.V2, t.V3})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Tuple3[A, B, C]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v Tuple3[A, B, C]
+	err := unmarshalTuple(data, &v.V1, &v.V2, &v.V3)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+func (t Tuple3[A, B, C]) pointers() []Pointer {
+	return []Pointer{t.V1, t.V2, t.V3}
+}
+
+// Tuple4 manages a composite pointer of four typed values.
+// Unlike List, it is encoded as a JSON array and decoded back to the concrete types of its values.
+type Tuple4[A, B, C, D Pointer] struct {
+	V1 A
+	V2 B
+	V3 C
+	V4 D
+}
+
+// Args implements the Pointer interface.
+// Each value is an argument, even a zero one, such as a score of 0.
+func (t Tuple4[A, B, C, D]) Args() []any {
+	return values(t.pointers())
+}
+
+// Compare implements the Comparer interface.
+// Tuples are compared lexicographically.
+func (t Tuple4[A, B, C, D]) Compare(other Pointer) int {
+	return compareLists(t.pointers(), mustBe[Tuple4[A, B, C, D]](t, other).pointers())
+}
+
+// IsZero implements the Pointer interface.
+func (t Tuple4[A, B, C, D]) IsZero() bool {
+	return isZero(t.pointers())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Tuple4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.V1, t.V2, t.V3, t.V4})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Tuple4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v Tuple4[A, B, C, D]
+	err := unmarshalTuple(data, &v.V1, &v.V2, &v.V3, &v.V4)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+func (t Tuple4[A, B, C, D]) pointers() []Pointer {
+	return []Pointer{t.V1, t.V2, t.V3, t.V4}
+}
+
+func unmarshalTuple(data []byte, values ...any) error {
+	var raw []json.RawMessage
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return fmt.Errorf("tuple: %w", err)
+	}
+	if len(raw) != len(values) {
+		return fmt.Errorf("tuple: got %d values, exp %d", len(raw), len(values))
+	}
+	for k := range raw {
+		err = json.Unmarshal(raw[k], values[k])
+		if err != nil {
+			return fmt.Errorf("tuple: value %d: %w", k+1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rvflash/cursor"
+)
+
+type createdAtID = cursor.Tuple2[cursor.Time, cursor.Int64]
+
+func TestTuple2_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  createdAtID
+		out []any
+	}{
+		"Default": {out: []any{time.Time{}, cursor.Int64(0)}},
+		"Partial": {
+			in:  cursor.NewTuple2(cursor.Time{}, cursor.Int64(next)),
+			out: []any{time.Time{}, cursor.Int64(next)},
+		},
+		"OK": {
+			in:  cursor.NewTuple2(cursor.NewTime(instant, cursor.Second), cursor.Int64(next)),
+			out: []any{time.Date(2025, 11, 2, 16, 35, 36, 0, time.UTC), cursor.Int64(next)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestTuple2_IsZero(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  createdAtID
+		out bool
+	}{
+		"Default": {out: true},
+		"Partial": {in: cursor.NewTuple2(cursor.Time{}, cursor.Int64(next))},
+		"OK":      {in: cursor.NewTuple2(cursor.NewTime(instant, cursor.Second), cursor.Int64(next))},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.IsZero()
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestTuple3_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.Tuple3[cursor.String, cursor.Decimal, cursor.Int64]
+		out string
+	}{
+		"Default": {out: `["","",0]`},
+		"OK": {
+			in:  cursor.NewTuple3(cursor.String("fr"), cursor.Decimal("19.99"), cursor.Int64(next)),
+			out: `["fr","19.99",3]`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := json.Marshal(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != tc.out {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestTuple3_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out cursor.Tuple3[cursor.String, cursor.Decimal, cursor.Int64]
+		msg string
+	}{
+		"Default": {msg: "unexpected end of JSON input"},
+		"Object":  {in: `{}`, msg: "tuple: json: cannot unmarshal object"},
+		"Arity":   {in: `["fr","19.99"]`, msg: "tuple: got 2 values, exp 3"},
+		"Type":    {in: `["fr","19.99","3"]`, msg: "tuple: value 3: json: cannot unmarshal string"},
+		"OK": {
+			in:  `["fr","19.99",3]`,
+			out: cursor.NewTuple3(cursor.String("fr"), cursor.Decimal("19.99"), cursor.Int64(next)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out cursor.Tuple3[cursor.String, cursor.Decimal, cursor.Int64]
+			err := json.Unmarshal([]byte(tc.in), &out)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestTuple4_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var (
+		in = cursor.NewTuple4(
+			cursor.NewTime(instant, cursor.Microsecond), cursor.Float64(0.1), cursor.Uint64String(1), v7,
+		)
+		out cursor.Tuple4[cursor.Time, cursor.Float64, cursor.Uint64String, cursor.UUID]
+	)
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = json.Unmarshal(b, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("\ngot %#v\nexp %#v", out, in)
+	}
+}
+
+func TestDecrypt_Tuple2(t *testing.T) {
+	t.Parallel()
+
+	var (
+		prv = cursor.NewTuple2(cursor.NewTime(instant, cursor.Millisecond), cursor.Int64(prev))
+		nxt = cursor.NewTuple2(cursor.NewTime(instant.Add(time.Hour), cursor.Millisecond), cursor.Int64(next))
+		in  = &cursor.Cursor[createdAtID]{Limit: limit, Prev: &prv, Next: &nxt}
+	)
+	b, err := cursor.Encrypt(in, []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := cursor.Decrypt[createdAtID](b, []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out.Prev, in.Prev) {
+		t.Errorf("\ngot %#v\nexp %#v", out.Prev, in.Prev)
+	}
+	if !reflect.DeepEqual(out.Next, in.Next) {
+		t.Errorf("\ngot %#v\nexp %#v", out.Next, in.Next)
+	}
+	st := cursor.Statement[createdAtID]{Cursor: out}
+	query, args := st.WhereCondition("created_at", "id")
+	if exp := " AND (created_at > ? OR (created_at = ? AND id >= ?))"; query != exp {
+		t.Errorf("\ngot %s\nexp %s", query, exp)
+	}
+	a := nxt.Args()
+	if exp := []any{a[0], a[0], a[1]}; !reflect.DeepEqual(args, exp) {
+		t.Errorf("\ngot %#v\nexp %#v", args, exp)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	uuidLen       = 16
+	uuidHexLen    = uuidLen * 2
+	uuidTextLen   = uuidHexLen + 4
+	uuidURNPrefix = "urn:uuid:"
+	uuidV7        = 7
+	uuidTimeLen   = 6
+)
+
+var errUUID = errors.New("invalid UUID format")
+
+// ParseUUID parses a RFC 9562 UUID in its canonical form, such as 01975e1a-4c3b-7d2e-8f10-2a3b4c5d6e7f.
+// The URN prefix, the surrounding braces or the lack of hyphens are also accepted.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	err := u.UnmarshalText([]byte(s))
+	return u, err
+}
+
+// UUID manages RFC 9562 UUID pointer stored as text, such as with the PostgreSQL uuid type.
+// Its arguments use the canonical string representation.
+// The byte order matches the generation order of the UUIDv7, based on a timestamp.
+type UUID [uuidLen]byte
+
+// Args implements the Pointer interface.
+func (u UUID) Args() []any {
+	if u.IsZero() {
+		return nil
+	}
+	return []any{u.String()}
+}
+
+// value implements the valuer interface.
+func (u UUID) value() any {
+	return u.String()
+}
+
+// Compare implements the Comparer interface.
+func (u UUID) Compare(other Pointer) int {
+	o := mustBe[UUID](u, other)
+	return bytes.Compare(u[:], o[:])
+}
+
+// IsZero implements the Pointer interface.
+// The Nil UUID is the zero value.
+func (u UUID) IsZero() bool {
+	return u == UUID{}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (u UUID) String() string {
+	var (
+		b = make([]byte, uuidTextLen)
+		j int
+	)
+	for k := range u {
+		switch k {
+		case 4, 6, 8, 10:
+			b[j] = '-'
+			j++
+		}
+		hex.Encode(b[j:j+2], u[k:k+1])
+		j += 2
+	}
+	return string(b)
+}
+
+// Time returns the timestamp of a UUIDv7, or the zero time for other versions.
+func (u UUID) Time() time.Time {
+	if u.Version() != uuidV7 {
+		return time.Time{}
+	}
+	var ms int64
+	for k := range uuidTimeLen {
+		ms = ms<<8 | int64(u[k])
+	}
+	return time.UnixMilli(ms).UTC()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *UUID) UnmarshalText(text []byte) error {
+	s := strings.TrimPrefix(strings.ToLower(string(text)), uuidURNPrefix)
+	if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+		s = s[1 : len(s)-1]
+	}
+	switch len(s) {
+	case uuidHexLen:
+	case uuidTextLen:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return fmt.Errorf("uuid: %w: %q", errUUID, text)
+		}
+		s = strings.ReplaceAll(s, "-", "")
+	default:
+		return fmt.Errorf("uuid: %w: %q", errUUID, text)
+	}
+	var v UUID
+	if n, err := hex.Decode(v[:], []byte(s)); err != nil || n != uuidLen {
+		return fmt.Errorf("uuid: %w: %q", errUUID, text)
+	}
+	*u = v
+	return nil
+}
+
+// Version returns the version of the UUID, such as 4 or 7.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// BinaryUUID manages RFC 9562 UUID pointer stored as BINARY(16), such as with MySQL or MariaDB.
+// It is encoded in the cursor like an UUID, but its arguments use the 16 raw bytes.
+type BinaryUUID UUID
+
+// Args implements the Pointer interface.
+func (u BinaryUUID) Args() []any {
+	if u.IsZero() {
+		return nil
+	}
+	return []any{u[:]}
+}
+
+// value implements the valuer interface.
+func (u BinaryUUID) value() any {
+	return u[:]
+}
+
+// Compare implements the Comparer interface.
+func (u BinaryUUID) Compare(other Pointer) int {
+	o := mustBe[BinaryUUID](u, other)
+	return bytes.Compare(u[:], o[:])
+}
+
+// IsZero implements the Pointer interface.
+func (u BinaryUUID) IsZero() bool {
+	return UUID(u).IsZero()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (u BinaryUUID) MarshalText() ([]byte, error) {
+	return UUID(u).MarshalText()
+}
+
+// String implements the fmt.Stringer interface.
+func (u BinaryUUID) String() string {
+	return UUID(u).String()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *BinaryUUID) UnmarshalText(text []byte) error {
+	return (*UUID)(u).UnmarshalText(text)
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package cursor_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rvflash/cursor"
+)
+
+const (
+	uuidV4 = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	uuidV7 = "019a3b5c-4d80-7cc3-98c4-dc0c0c07398f"
+)
+
+var (
+	v4 = cursor.UUID{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}
+	v7 = cursor.UUID{0x01, 0x9a, 0x3b, 0x5c, 0x4d, 0x80, 0x7c, 0xc3, 0x98, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f}
+)
+
+func TestParseUUID(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		// inputs
+		in string
+		// outputs
+		out cursor.UUID
+		msg string
+	}{
+		"Default":    {msg: "uuid: invalid UUID format"},
+		"Invalid":    {in: "f47ac10b-58cc-4372-a567-0e02b2c3d47z", msg: "uuid: invalid UUID format"},
+		"Hyphens":    {in: "f47ac10b58cc-4372-a567-0e02b2c3d4790", msg: "uuid: invalid UUID format"},
+		"Nil":        {in: "00000000-0000-0000-0000-000000000000"},
+		"Canonical":  {in: uuidV4, out: v4},
+		"Upper case": {in: "F47AC10B-58CC-4372-A567-0E02B2C3D479", out: v4},
+		"Compact":    {in: "f47ac10b58cc4372a5670e02b2c3d479", out: v4},
+		"Braces":     {in: "{" + uuidV4 + "}", out: v4},
+		"URN":        {in: "urn:uuid:" + uuidV4, out: v4},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := cursor.ParseUUID(tc.in)
+			if err != nil || tc.msg != "" {
+				checkErr(t, err, tc.msg)
+			}
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestUUID_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.UUID
+		out []any
+	}{
+		"Default": {},
+		"OK":      {in: v4, out: []any{uuidV4}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestUUID_IsZero(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.UUID
+		out bool
+	}{
+		"Default": {out: true},
+		"OK":      {in: v4},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.IsZero()
+			if out != tc.out {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestUUID_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var (
+		in  = cursor.NewTuple2(v7, cursor.BinaryUUID(v4))
+		exp = `["` + uuidV7 + `","` + uuidV4 + `"]`
+	)
+	out, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != exp {
+		t.Errorf("\ngot %s\nexp %s", out, exp)
+	}
+}
+
+func TestUUID_Time(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.UUID
+		out time.Time
+	}{
+		"Default": {},
+		"Not v7":  {in: v4},
+		"OK":      {in: v7, out: time.UnixMilli(1761932496256).UTC()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Time()
+			if !out.Equal(tc.out) {
+				t.Errorf("\ngot %s\nexp %s", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestUUID_Version(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.UUID
+		out int
+	}{
+		"Default": {},
+		"v4":      {in: v4, out: 4},
+		"v7":      {in: v7, out: 7},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Version()
+			if out != tc.out {
+				t.Errorf("\ngot %d\nexp %d", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestBinaryUUID_Args(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		in  cursor.BinaryUUID
+		out []any
+	}{
+		"Default": {},
+		"OK":      {in: cursor.BinaryUUID(v4), out: []any{v4[:]}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out := tc.in.Args()
+			if !reflect.DeepEqual(out, tc.out) {
+				t.Errorf("\ngot %#v\nexp %#v", out, tc.out)
+			}
+		})
+	}
+}
+
+func TestCursor_Decode_BinaryUUID(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nxt = cursor.BinaryUUID(v7)
+		in  = cursor.Cursor[cursor.BinaryUUID]{Limit: limit, Next: &nxt}
+		out cursor.Cursor[cursor.BinaryUUID]
+	)
+	b, err := in.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = out.Decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out.Next, in.Next) {
+		t.Errorf("\ngot %#v\nexp %#v", out.Next, in.Next)
+	}
+}